@@ -0,0 +1,78 @@
+package carbon
+
+import (
+	"time"
+)
+
+// Age 获取当前实例(出生日期)至今的周岁，与NextYears一致，2月29日出生的人在平年的2月28日长一岁
+func (c Carbon) Age() int {
+	if c.Error != nil || c.Time.IsZero() {
+		return 0
+	}
+	return c.AgeAt(Carbon{Time: c.now(), loc: c.loc})
+}
+
+// AgeAt 获取当前实例(出生日期)至指定日期的周岁，指定日期早于出生日期时返回0
+func (c Carbon) AgeAt(end Carbon) int {
+	if c.Error != nil || end.Error != nil || c.Time.IsZero() || end.Time.IsZero() {
+		return 0
+	}
+	date := c.dateOf(end.Time)
+	age := date.Year() - c.Time.Year()
+	if date.Before(c.birthdayIn(date.Year())) {
+		age--
+	}
+	if age < 0 {
+		return 0
+	}
+	return age
+}
+
+// IsBirthday 指定日期是否是当前实例(出生日期)的生日，出生当天不算生日
+func (c Carbon) IsBirthday(date Carbon) bool {
+	if c.Error != nil || date.Error != nil || c.Time.IsZero() || date.Time.IsZero() {
+		return false
+	}
+	d := c.dateOf(date.Time)
+	return d.Year() > c.Time.Year() && d.Equal(c.birthdayIn(d.Year()))
+}
+
+// NextBirthday 下一个生日(当天零点)，今天是生日时返回今天
+func (c Carbon) NextBirthday() Carbon {
+	if c.Error != nil || c.Time.IsZero() {
+		return c
+	}
+	today := c.dateOf(c.now())
+	year := today.Year()
+	if year <= c.Time.Year() {
+		year = c.Time.Year() + 1
+	}
+	birthday := c.birthdayIn(year)
+	if birthday.Before(today) {
+		birthday = c.birthdayIn(year + 1)
+	}
+	c.Time = birthday
+	return c
+}
+
+// DaysUntilBirthday 距离下一个生日的天数，今天是生日时返回0
+func (c Carbon) DaysUntilBirthday() int {
+	if c.Error != nil || c.Time.IsZero() {
+		return 0
+	}
+	today := c.dateOf(c.now())
+	birthday := c.NextBirthday().Time
+	// 按日历日计算，避免夏令时切换导致的误差
+	return int(time.Date(birthday.Year(), birthday.Month(), birthday.Day(), 0, 0, 0, 0, time.UTC).Sub(time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)).Hours() / HoursPerDay)
+}
+
+// birthdayIn 获取指定年份的生日(当天零点)
+func (c Carbon) birthdayIn(year int) time.Time {
+	return c.dateOf(c.NextYears(year - c.Time.Year()).Time)
+}
+
+// dateOf 获取时间在当前实例时区的当天零点
+func (c Carbon) dateOf(t time.Time) time.Time {
+	year, month, day := t.In(c.location()).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, c.location())
+}
@@ -0,0 +1,100 @@
+package carbon
+
+import (
+	"testing"
+)
+
+func TestCarbon_AgeAt(t *testing.T) {
+	Tests := []struct {
+		input  string // 输入值
+		param  string // 输入参数
+		output int    // 期望输出值
+	}{
+		{"0000-00-00", "2020-08-05", 0},
+		{"2000-08-05", "0000-00-00", 0},
+		{"2000-08-05 13:14:15", "2020-08-05", 20},
+		{"2000-08-05 13:14:15", "2020-08-04 23:59:59", 19},
+		{"2000-08-05", "1999-08-05", 0},
+		{"2000-02-29", "2021-02-27", 20},
+		{"2000-02-29", "2021-02-28", 21},
+		{"2000-02-29", "2024-02-28", 23},
+		{"2000-02-29", "2024-02-29", 24},
+	}
+
+	for _, v := range Tests {
+		output := Parse(v.input).AgeAt(Parse(v.param))
+
+		if output != v.output {
+			t.Fatalf("Input %s, expected %d, but got %d\n", v.input, v.output, output)
+		}
+	}
+}
+
+func TestCarbon_IsBirthday(t *testing.T) {
+	Tests := []struct {
+		input  string // 输入值
+		param  string // 输入参数
+		output bool   // 期望输出值
+	}{
+		{"0000-00-00", "2020-08-05", false},
+		{"2000-08-05", "0000-00-00", false},
+		{"2000-08-05 13:14:15", "2020-08-05 08:00:00", true},
+		{"2000-08-05", "2000-08-05", false},
+		{"2000-08-05", "2020-08-06", false},
+		{"2000-02-29", "2021-02-28", true},
+		{"2000-02-29", "2021-03-01", false},
+		{"2000-02-29", "2024-02-28", false},
+		{"2000-02-29", "2024-02-29", true},
+	}
+
+	for _, v := range Tests {
+		output := Parse(v.input).IsBirthday(Parse(v.param))
+
+		if output != v.output {
+			t.Fatalf("Input %s, expected %t, but got %t\n", v.input, v.output, output)
+		}
+	}
+}
+
+func TestCarbon_NextBirthday(t *testing.T) {
+	SetTestNow(Parse("2020-08-05 13:14:15"))
+	defer ClearTestNow()
+
+	Tests := []struct {
+		input  string // 输入值
+		output string // 期望输出值
+		days   int    // 期望距离天数
+		age    int    // 期望周岁
+	}{
+		{"2000-08-05 20:00:00", "2020-08-05 00:00:00", 0, 20},
+		{"2000-08-04", "2021-08-04 00:00:00", 364, 20},
+		{"2000-08-06", "2020-08-06 00:00:00", 1, 19},
+		{"2000-02-29", "2021-02-28 00:00:00", 207, 20},
+		{"2020-08-05", "2021-08-05 00:00:00", 365, 0},
+		{"2021-01-01", "2022-01-01 00:00:00", 514, 0},
+	}
+
+	for _, v := range Tests {
+		c := Parse(v.input)
+
+		if c.NextBirthday().ToDateTimeString() != v.output {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.output, c.NextBirthday().ToDateTimeString())
+		}
+
+		if c.DaysUntilBirthday() != v.days {
+			t.Fatalf("Input %s, expected %d, but got %d\n", v.input, v.days, c.DaysUntilBirthday())
+		}
+
+		if c.Age() != v.age {
+			t.Fatalf("Input %s, expected %d, but got %d\n", v.input, v.age, c.Age())
+		}
+	}
+
+	if !Parse("").NextBirthday().IsZero() || Parse("xxx").NextBirthday().Error == nil || Parse("").DaysUntilBirthday() != 0 || Parse("").Age() != 0 {
+		t.Fatal("Expected zero value and error to be kept\n")
+	}
+
+	if Parse("2000-08-05").Timezone(Tokyo).NextBirthday().ToFormatString("Y-m-d H:i:s e") != "2020-08-05 00:00:00 Asia/Tokyo" {
+		t.Fatal("Expected the timezone to be kept\n")
+	}
+}
@@ -0,0 +1,319 @@
+package carbon
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"sync"
+	"time"
+)
+
+// BusinessCalendar 工作日历，包含周末定义、节假日及调休工作日，并发安全
+type BusinessCalendar struct {
+	mutex    sync.RWMutex
+	weekends map[time.Weekday]bool
+	holidays map[string]string // 节假日，日期 => 名称
+	workdays map[string]string // 调休工作日(原本是周末的工作日)，日期 => 名称
+}
+
+// businessCalendarFile 工作日历JSON文件格式
+type businessCalendarFile struct {
+	Weekends []time.Weekday    `json:"weekends"`
+	Holidays map[string]string `json:"holidays"`
+	Workdays map[string]string `json:"workdays"`
+}
+
+var (
+	// 全局工作日历
+	globalBusinessCalendar = NewBusinessCalendar()
+
+	// 全局工作日历读写锁
+	businessMutex sync.RWMutex
+)
+
+// NewBusinessCalendar 创建工作日历，不传参数时周末为周六和周日
+func NewBusinessCalendar(weekends ...time.Weekday) *BusinessCalendar {
+	if len(weekends) == 0 {
+		weekends = []time.Weekday{time.Saturday, time.Sunday}
+	}
+	bc := &BusinessCalendar{holidays: make(map[string]string), workdays: make(map[string]string)}
+	bc.SetWeekends(weekends...)
+	return bc
+}
+
+// SetWeekends 设置周末
+func (bc *BusinessCalendar) SetWeekends(weekends ...time.Weekday) {
+	bc.mutex.Lock()
+	defer bc.mutex.Unlock()
+	bc.weekends = make(map[time.Weekday]bool, len(weekends))
+	for _, day := range weekends {
+		bc.weekends[day] = true
+	}
+}
+
+// AddHoliday 添加节假日，日期格式为2006-01-02
+func (bc *BusinessCalendar) AddHoliday(date string, name string) error {
+	key, err := businessDateKey(date)
+	if err != nil {
+		return err
+	}
+	bc.mutex.Lock()
+	defer bc.mutex.Unlock()
+	bc.holidays[key] = name
+	delete(bc.workdays, key)
+	return nil
+}
+
+// RemoveHoliday 移除节假日
+func (bc *BusinessCalendar) RemoveHoliday(date string) error {
+	key, err := businessDateKey(date)
+	if err != nil {
+		return err
+	}
+	bc.mutex.Lock()
+	defer bc.mutex.Unlock()
+	delete(bc.holidays, key)
+	return nil
+}
+
+// AddWorkday 添加调休工作日，该日即使是周末也视为工作日
+func (bc *BusinessCalendar) AddWorkday(date string, name string) error {
+	key, err := businessDateKey(date)
+	if err != nil {
+		return err
+	}
+	bc.mutex.Lock()
+	defer bc.mutex.Unlock()
+	bc.workdays[key] = name
+	delete(bc.holidays, key)
+	return nil
+}
+
+// RemoveWorkday 移除调休工作日
+func (bc *BusinessCalendar) RemoveWorkday(date string) error {
+	key, err := businessDateKey(date)
+	if err != nil {
+		return err
+	}
+	bc.mutex.Lock()
+	defer bc.mutex.Unlock()
+	delete(bc.workdays, key)
+	return nil
+}
+
+// LoadFile 从JSON文件加载周末、节假日及调休工作日，已存在的日期将被覆盖
+// 文件格式如 {"weekends": [0, 6], "holidays": {"2021-01-01": "New Year's Day"}, "workdays": {}}，weekends可省略
+func (bc *BusinessCalendar) LoadFile(file string) error {
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return loadBusinessCalendarError(file, err)
+	}
+	return bc.load(content, file)
+}
+
+// load 从JSON内容加载工作日历，先校验全部日期再写入
+func (bc *BusinessCalendar) load(content []byte, file string) error {
+	var data businessCalendarFile
+	if err := json.Unmarshal(content, &data); err != nil {
+		return loadBusinessCalendarError(file, err)
+	}
+	holidays, workdays := make(map[string]string), make(map[string]string)
+	for date, name := range data.Holidays {
+		key, err := businessDateKey(date)
+		if err != nil {
+			return loadBusinessCalendarError(file, err)
+		}
+		holidays[key] = name
+	}
+	for date, name := range data.Workdays {
+		key, err := businessDateKey(date)
+		if err != nil {
+			return loadBusinessCalendarError(file, err)
+		}
+		workdays[key] = name
+	}
+
+	if data.Weekends != nil {
+		bc.SetWeekends(data.Weekends...)
+	}
+	bc.mutex.Lock()
+	defer bc.mutex.Unlock()
+	for key, name := range holidays {
+		bc.holidays[key] = name
+		delete(bc.workdays, key)
+	}
+	for key, name := range workdays {
+		bc.workdays[key] = name
+		delete(bc.holidays, key)
+	}
+	return nil
+}
+
+// IsBusinessDay 是否是工作日
+func (bc *BusinessCalendar) IsBusinessDay(t time.Time) bool {
+	key := t.Format(DateFormat)
+	bc.mutex.RLock()
+	defer bc.mutex.RUnlock()
+	if _, ok := bc.workdays[key]; ok {
+		return true
+	}
+	if _, ok := bc.holidays[key]; ok {
+		return false
+	}
+	return !bc.weekends[t.Weekday()]
+}
+
+// HolidayName 获取节假日名称，非节假日时返回空字符串
+func (bc *BusinessCalendar) HolidayName(t time.Time) string {
+	bc.mutex.RLock()
+	defer bc.mutex.RUnlock()
+	return bc.holidays[t.Format(DateFormat)]
+}
+
+// SetBusinessCalendar 设置全局工作日历，传入nil时恢复为默认工作日历(周六、周日休息，无节假日)
+func SetBusinessCalendar(bc *BusinessCalendar) {
+	businessMutex.Lock()
+	defer businessMutex.Unlock()
+	if bc == nil {
+		bc = NewBusinessCalendar()
+	}
+	globalBusinessCalendar = bc
+}
+
+// GetBusinessCalendar 获取全局工作日历
+func GetBusinessCalendar() *BusinessCalendar {
+	businessMutex.RLock()
+	defer businessMutex.RUnlock()
+	return globalBusinessCalendar
+}
+
+// SetBusinessCalendar 设置工作日历(仅对当前实例有效)，传入nil时使用全局工作日历
+func (c Carbon) SetBusinessCalendar(bc *BusinessCalendar) Carbon {
+	c.businessCalendar = bc
+	return c
+}
+
+// GetBusinessCalendar 获取当前实例工作日历，未设置时返回全局工作日历
+func (c Carbon) GetBusinessCalendar() *BusinessCalendar {
+	if c.businessCalendar == nil {
+		return GetBusinessCalendar()
+	}
+	return c.businessCalendar
+}
+
+// IsBusinessDay 是否是工作日(考虑节假日及调休)
+func (c Carbon) IsBusinessDay() bool {
+	if c.Time.IsZero() {
+		return false
+	}
+	return c.GetBusinessCalendar().IsBusinessDay(c.Time)
+}
+
+// AddBusinessDays N个工作日后，时分秒保持不变
+func (c Carbon) AddBusinessDays(days int) Carbon {
+	if c.Error != nil {
+		return c
+	}
+	step := 1
+	if days < 0 {
+		step, days = -1, -days
+	}
+	bc := c.GetBusinessCalendar()
+	t := c.Time
+	for ; days > 0; days-- {
+		skipped := 0
+		for t = t.AddDate(0, 0, step); !bc.IsBusinessDay(t); t = t.AddDate(0, 0, step) {
+			// 一年内都没有工作日时视为无效的工作日历，避免死循环
+			if skipped++; skipped > DaysPerLeapYear {
+				return c.withError(invalidBusinessCalendarError())
+			}
+		}
+	}
+	c.Time = t
+	return c
+}
+
+// AddBusinessDay 1个工作日后
+func (c Carbon) AddBusinessDay() Carbon {
+	return c.AddBusinessDays(1)
+}
+
+// SubBusinessDays N个工作日前
+func (c Carbon) SubBusinessDays(days int) Carbon {
+	return c.AddBusinessDays(-days)
+}
+
+// SubBusinessDay 1个工作日前
+func (c Carbon) SubBusinessDay() Carbon {
+	return c.AddBusinessDays(-1)
+}
+
+// NextBusinessDay 下一个工作日(不包括当天)
+func (c Carbon) NextBusinessDay() Carbon {
+	return c.AddBusinessDays(1)
+}
+
+// PreBusinessDay 上一个工作日(不包括当天)
+func (c Carbon) PreBusinessDay() Carbon {
+	return c.AddBusinessDays(-1)
+}
+
+// DiffInBusinessDays 相差多少工作日(不包括开始日期，包括结束日期)，结束日期早于开始日期时返回负数
+func (c Carbon) DiffInBusinessDays(end Carbon) int64 {
+	if c.Time.IsZero() || end.Time.IsZero() {
+		return 0
+	}
+	start, stop := c.Time, end.Time.In(c.Time.Location())
+	sy, sm, sd := start.Date()
+	ey, em, ed := stop.Date()
+	from := time.Date(sy, sm, sd, 12, 0, 0, 0, start.Location())
+	to := time.Date(ey, em, ed, 12, 0, 0, 0, start.Location())
+	sign := int64(1)
+	if to.Before(from) {
+		from, to, sign = to, from, -1
+	}
+
+	bc := c.GetBusinessCalendar()
+	days := int64(0)
+	for t := from.AddDate(0, 0, 1); !t.After(to); t = t.AddDate(0, 0, 1) {
+		if bc.IsBusinessDay(t) {
+			days++
+		}
+	}
+	return sign * days
+}
+
+// BusinessDaysInMonth 获取本月的工作日天数
+func (c Carbon) BusinessDaysInMonth() int {
+	if c.Time.IsZero() {
+		return 0
+	}
+	bc := c.GetBusinessCalendar()
+	days := 0
+	year, month, _ := c.Time.Date()
+	for day := 1; day <= daysInMonth(year, month); day++ {
+		if bc.IsBusinessDay(time.Date(year, month, day, 12, 0, 0, 0, c.Time.Location())) {
+			days++
+		}
+	}
+	return days
+}
+
+// HolidayName 按照工作日历获取节假日名称，工作日历中没有该日期时使用中国法定节假日名称，均不是节假日时返回空字符串
+func (c Carbon) HolidayName() string {
+	if c.Time.IsZero() {
+		return ""
+	}
+	if name := c.GetBusinessCalendar().HolidayName(c.Time); name != "" {
+		return name
+	}
+	return c.ChineseHolidayName()
+}
+
+// businessDateKey 校验并格式化日期
+func businessDateKey(date string) (string, error) {
+	t, err := parseByLayout(date, DateFormat)
+	if err != nil {
+		return "", err
+	}
+	return t.Format(DateFormat), nil
+}
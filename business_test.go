@@ -0,0 +1,201 @@
+package carbon
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+func newTestBusinessCalendar(t *testing.T) *BusinessCalendar {
+	bc := NewBusinessCalendar()
+	if err := bc.AddHoliday("2020-10-01", "National Day"); err != nil {
+		t.Fatalf("Unexpected error %v\n", err)
+	}
+	bc.AddHoliday("2020-10-02", "National Day")
+	bc.AddWorkday("2020-10-10", "National Day")
+	return bc
+}
+
+func TestCarbon_IsBusinessDay(t *testing.T) {
+	bc := newTestBusinessCalendar(t)
+	Tests := []struct {
+		input       string // 输入值
+		isBusiness  bool   // 期望是否是工作日
+		holidayName string // 期望节假日名称
+	}{
+		{"0000-00-00", false, ""},
+		{"2020-09-30 13:14:15", true, ""},
+		{"2020-10-01 13:14:15", false, "National Day"},
+		{"2020-10-03", false, "国庆节、中秋节"},
+		{"2020-10-09", true, ""},
+		{"2020-10-10", true, ""},
+		{"2020-10-11", false, ""},
+	}
+
+	for _, v := range Tests {
+		c := Parse(v.input).SetBusinessCalendar(bc)
+
+		if c.IsBusinessDay() != v.isBusiness {
+			t.Fatalf("Input %s, expected %t, but got %t\n", v.input, v.isBusiness, c.IsBusinessDay())
+		}
+
+		if c.HolidayName() != v.holidayName {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.holidayName, c.HolidayName())
+		}
+	}
+}
+
+func TestCarbon_AddBusinessDays(t *testing.T) {
+	bc := newTestBusinessCalendar(t)
+	Tests := []struct {
+		input  string // 输入值
+		days   int    // 输入参数
+		output string // 期望输出值
+	}{
+		{"2020-09-30 13:14:15", 1, "2020-10-05 13:14:15"},
+		{"2020-09-30 13:14:15", 6, "2020-10-10 13:14:15"},
+		{"2020-10-03 13:14:15", 1, "2020-10-05 13:14:15"},
+		{"2020-10-05 13:14:15", -1, "2020-09-30 13:14:15"},
+		{"2020-10-12 13:14:15", -1, "2020-10-10 13:14:15"},
+		{"2020-10-03 13:14:15", 0, "2020-10-03 13:14:15"},
+		{"xxx", 1, ""},
+	}
+
+	for _, v := range Tests {
+		output := Parse(v.input).SetBusinessCalendar(bc).AddBusinessDays(v.days).ToDateTimeString()
+
+		if output != v.output {
+			t.Fatalf("Input %s(%d), expected %s, but got %s\n", v.input, v.days, v.output, output)
+		}
+
+		output = Parse(v.input).SetBusinessCalendar(bc).SubBusinessDays(-v.days).ToDateTimeString()
+
+		if output != v.output {
+			t.Fatalf("Input %s(%d), expected %s, but got %s\n", v.input, v.days, v.output, output)
+		}
+	}
+
+	c := Parse("2020-09-30 13:14:15").SetBusinessCalendar(bc)
+	if c.NextBusinessDay().ToDateString() != "2020-10-05" || c.AddBusinessDay().ToDateString() != "2020-10-05" || c.PreBusinessDay().ToDateString() != "2020-09-29" || c.SubBusinessDay().ToDateString() != "2020-09-29" {
+		t.Fatal("Unexpected next or previous business day\n")
+	}
+
+	allWeekends := NewBusinessCalendar(time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday)
+	if Parse("2020-10-01").SetBusinessCalendar(allWeekends).AddBusinessDays(1).Error == nil {
+		t.Fatal("Expected error with a calendar without business days\n")
+	}
+}
+
+func TestCarbon_DiffInBusinessDays(t *testing.T) {
+	bc := newTestBusinessCalendar(t)
+	Tests := []struct {
+		start  string // 开始时间
+		end    string // 结束时间
+		output int64  // 期望输出值
+	}{
+		{"2020-09-30 13:14:15", "2020-09-30 23:00:00", 0},
+		{"2020-09-30 13:14:15", "2020-10-05 00:00:00", 1},
+		{"2020-09-30 13:14:15", "2020-10-12 13:14:15", 7},
+		{"2020-10-12 13:14:15", "2020-09-30 13:14:15", -7},
+		{"2020-10-01", "2020-10-04", 0},
+		{"0000-00-00", "2020-10-04", 0},
+	}
+
+	for _, v := range Tests {
+		output := Parse(v.start).SetBusinessCalendar(bc).DiffInBusinessDays(Parse(v.end))
+
+		if output != v.output {
+			t.Fatalf("Input %s and %s, expected %d, but got %d\n", v.start, v.end, v.output, output)
+		}
+	}
+}
+
+func TestCarbon_BusinessDaysInMonth(t *testing.T) {
+	bc := newTestBusinessCalendar(t)
+	Tests := []struct {
+		input  string // 输入值
+		output int    // 期望输出值
+	}{
+		{"0000-00-00", 0},
+		{"2020-08-05", 21},
+		{"2020-10-05", 21},
+		{"2020-02-29", 20},
+	}
+
+	for _, v := range Tests {
+		output := Parse(v.input).SetBusinessCalendar(bc).BusinessDaysInMonth()
+
+		if output != v.output {
+			t.Fatalf("Input %s, expected %d, but got %d\n", v.input, v.output, output)
+		}
+	}
+}
+
+func TestBusinessCalendar_LoadFile(t *testing.T) {
+	file, err := ioutil.TempFile("", "business-*.json")
+	if err != nil {
+		t.Fatalf("Unexpected error %v\n", err)
+	}
+	defer os.Remove(file.Name())
+	file.WriteString(`{"weekends": [5, 6], "holidays": {"2021-01-03": "New Year"}, "workdays": {"2021-01-08": "Makeup"}}`)
+	file.Close()
+
+	bc := NewBusinessCalendar()
+	if err := bc.LoadFile(file.Name()); err != nil {
+		t.Fatalf("Unexpected error %v\n", err)
+	}
+
+	Tests := []struct {
+		input  string // 输入值
+		output bool   // 期望输出值
+	}{
+		{"2021-01-01", false},
+		{"2021-01-02", false},
+		{"2021-01-03", false},
+		{"2021-01-04", true},
+		{"2021-01-08", true},
+		{"2021-01-10", true},
+	}
+
+	for _, v := range Tests {
+		output := Parse(v.input).SetBusinessCalendar(bc).IsBusinessDay()
+
+		if output != v.output {
+			t.Fatalf("Input %s, expected %t, but got %t\n", v.input, v.output, output)
+		}
+	}
+
+	if bc.LoadFile("xxx.json") == nil {
+		t.Fatal("Expected error with a missing file\n")
+	}
+
+	if bc.load([]byte(`{"holidays": {"2021-13-01": "xxx"}}`), "xxx.json") == nil || bc.load([]byte(`xxx`), "xxx.json") == nil {
+		t.Fatal("Expected error with an invalid file\n")
+	}
+
+	if bc.AddHoliday("xxx", "") == nil || bc.RemoveHoliday("xxx") == nil || bc.AddWorkday("xxx", "") == nil || bc.RemoveWorkday("xxx") == nil {
+		t.Fatal("Expected error with an invalid date\n")
+	}
+
+	bc.RemoveHoliday("2021-01-03")
+	bc.RemoveWorkday("2021-01-08")
+	if !Parse("2021-01-03").SetBusinessCalendar(bc).IsBusinessDay() || Parse("2021-01-08").SetBusinessCalendar(bc).IsBusinessDay() {
+		t.Fatal("Expected holiday and workday to be removed\n")
+	}
+}
+
+func TestCarbon_SetBusinessCalendarGlobally(t *testing.T) {
+	bc := newTestBusinessCalendar(t)
+	SetBusinessCalendar(bc)
+	defer SetBusinessCalendar(nil)
+
+	if GetBusinessCalendar() != bc || Parse("2020-10-01").IsBusinessDay() || Parse("2020-10-01").SetBusinessCalendar(NewBusinessCalendar()).AddDay().Timezone(Tokyo).GetBusinessCalendar() == bc {
+		t.Fatal("Expected the global business calendar to be used\n")
+	}
+
+	SetBusinessCalendar(nil)
+	if !Parse("2020-10-01").IsBusinessDay() {
+		t.Fatal("Expected the default business calendar to be restored\n")
+	}
+}
@@ -5,19 +5,26 @@ var (
 	SymbolicAnimals = [12]string{"猴", "鸡", "狗", "猪", "鼠", "牛", "虎", "兔", "龙", "蛇", "马", "羊"}
 
 	// 天干
-	HeavenlyStems = [10]string{"庚", "辛", "壬", "癸", "甲", "乙", "丙", "丙", "戊", "己"}
+	HeavenlyStems = [10]string{"庚", "辛", "壬", "癸", "甲", "乙", "丙", "丁", "戊", "己"}
 
 	// 地支
 	EarthlyBranches = [12]string{"申", "酉", "戌", "亥", "子", "丑", "寅", "卯", "辰", "巳", "午", "未"}
 )
 
+// lunarYear 获取农历年，超出农历数据表范围时使用公历年
+func (c Carbon) lunarYear() int {
+	if l := c.Lunar(); !l.IsZero() {
+		return l.Year()
+	}
+	return c.Time.Year()
+}
+
 // ToAnimalYear 获取生肖年
 func (c Carbon) ToAnimalYear() string {
 	if c.Time.IsZero() {
 		return ""
 	}
-	year := c.Time.Year()
-	return SymbolicAnimals[year%12]
+	return SymbolicAnimals[c.lunarYear()%12]
 }
 
 // ToLunarYear 获取农历年
@@ -25,13 +32,13 @@ func (c Carbon) ToLunarYear() string {
 	if c.Time.IsZero() {
 		return ""
 	}
-	year := c.Time.Year()
+	year := c.lunarYear()
 	return HeavenlyStems[year%10] + EarthlyBranches[year%12]
 }
 
 // IsYearOfRat 是否是鼠年
 func (c Carbon) IsYearOfRat() bool {
-	year := c.Time.Year()
+	year := c.lunarYear()
 	if year%12 == 4 {
 		return true
 	}
@@ -40,7 +47,7 @@ func (c Carbon) IsYearOfRat() bool {
 
 // IsYearOfOx 是否是牛年
 func (c Carbon) IsYearOfOx() bool {
-	year := c.Time.Year()
+	year := c.lunarYear()
 	if year%12 == 5 {
 		return true
 	}
@@ -49,7 +56,7 @@ func (c Carbon) IsYearOfOx() bool {
 
 // IsYearOfTiger 是否是虎年
 func (c Carbon) IsYearOfTiger() bool {
-	year := c.Time.Year()
+	year := c.lunarYear()
 	if year%12 == 6 {
 		return true
 	}
@@ -58,7 +65,7 @@ func (c Carbon) IsYearOfTiger() bool {
 
 // IsYearOfRabbit 是否是兔年
 func (c Carbon) IsYearOfRabbit() bool {
-	year := c.Time.Year()
+	year := c.lunarYear()
 	if year%12 == 7 {
 		return true
 	}
@@ -67,7 +74,7 @@ func (c Carbon) IsYearOfRabbit() bool {
 
 // IsYearOfDragon 是否是龙年
 func (c Carbon) IsYearOfDragon() bool {
-	year := c.Time.Year()
+	year := c.lunarYear()
 	if year%12 == 8 {
 		return true
 	}
@@ -76,7 +83,7 @@ func (c Carbon) IsYearOfDragon() bool {
 
 // IsYearOfSnake 是否是蛇年
 func (c Carbon) IsYearOfSnake() bool {
-	year := c.Time.Year()
+	year := c.lunarYear()
 	if year%12 == 9 {
 		return true
 	}
@@ -85,7 +92,7 @@ func (c Carbon) IsYearOfSnake() bool {
 
 // IsYearOfHorse 是否是马年
 func (c Carbon) IsYearOfHorse() bool {
-	year := c.Time.Year()
+	year := c.lunarYear()
 	if year%12 == 10 {
 		return true
 	}
@@ -94,7 +101,7 @@ func (c Carbon) IsYearOfHorse() bool {
 
 // IsYearOfGoat 是否是羊年
 func (c Carbon) IsYearOfGoat() bool {
-	year := c.Time.Year()
+	year := c.lunarYear()
 	if year%12 == 11 {
 		return true
 	}
@@ -103,7 +110,7 @@ func (c Carbon) IsYearOfGoat() bool {
 
 // IsYearOfMonkey 是否是猴年
 func (c Carbon) IsYearOfMonkey() bool {
-	year := c.Time.Year()
+	year := c.lunarYear()
 	if year%12 == 0 {
 		return true
 	}
@@ -112,7 +119,7 @@ func (c Carbon) IsYearOfMonkey() bool {
 
 // IsYearOfRooster 是否是鸡年
 func (c Carbon) IsYearOfRooster() bool {
-	year := c.Time.Year()
+	year := c.lunarYear()
 	if year%12 == 1 {
 		return true
 	}
@@ -121,7 +128,7 @@ func (c Carbon) IsYearOfRooster() bool {
 
 // IsYearOfDog 是否是狗年
 func (c Carbon) IsYearOfDog() bool {
-	year := c.Time.Year()
+	year := c.lunarYear()
 	if year%12 == 2 {
 		return true
 	}
@@ -130,7 +137,7 @@ func (c Carbon) IsYearOfDog() bool {
 
 // IsYearOfPig 是否是猪年
 func (c Carbon) IsYearOfPig() bool {
-	year := c.Time.Year()
+	year := c.lunarYear()
 	if year%12 == 3 {
 		return true
 	}
@@ -8,6 +8,8 @@ func TestCarbon_ToAnimalYear(t *testing.T) {
 		output string // 期望输出值
 	}{
 		{"0000-00-00", ""},
+		{"2020-01-24", "猪"},
+		{"2020-01-25", "鼠"},
 		{"2020-08-05", "鼠"},
 		{"2021-08-05", "牛"},
 		{"2022-08-05", "虎"},
@@ -42,7 +44,10 @@ func TestCarbon_ToLunarYear(t *testing.T) {
 		{"1898-06-11", "戊戌"}, // 戊戌变法发生日期
 		{"1901-09-07", "辛丑"}, // 辛丑条约签署日期
 		{"1911-10-10", "辛亥"}, // 辛亥革命发生日期
+		{"1937-07-07", "丁丑"}, // 七七事变发生日期
 		{"1900-08-28", "庚子"}, // 庚子赔款发生日期
+		{"2020-01-24", "己亥"},
+		{"2020-01-25", "庚子"},
 	}
 
 	for _, v := range Tests {
@@ -59,7 +64,9 @@ func TestCarbon_IsYearOfRat(t *testing.T) {
 		input  string // 输入值
 		output bool   // 期望输出值
 	}{
+		{"2020-01-24", false},
 		{"2020-08-05", true},
+		{"2021-02-11", true},
 		{"2021-08-05", false},
 		{"2022-08-05", false},
 		{"2023-08-05", false},
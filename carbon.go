@@ -6,49 +6,67 @@ import (
 )
 
 type Carbon struct {
-	Time time.Time
-	loc  *time.Location
+	Time                 time.Time
+	loc                  *time.Location
+	locale               string
+	clock                Clock
+	fiscalYearStartMonth int
+	weekStartsAt         *time.Weekday
+	businessCalendar     *BusinessCalendar
+	ganZhiYearBoundary   GanZhiYearBoundary
+	Error                error
 }
 
 // Timezone 设置时区
 func Timezone(name string) Carbon {
-	return Carbon{loc: getLocalByTimezone(name)}
+	loc, err := getLocalByTimezone(name)
+	if err != nil {
+		return Carbon{loc: time.Local, Error: err}
+	}
+	return Carbon{loc: loc}
 }
 
 // Timezone 设置时区
 func (c Carbon) Timezone(name string) Carbon {
-	loc := getLocalByTimezone(name)
-	return Carbon{Time: c.Time.In(c.loc), loc: loc}
+	if c.Error != nil {
+		return c
+	}
+	loc, err := getLocalByTimezone(name)
+	if err != nil {
+		return c.withError(err)
+	}
+	c.setTime(c.Time.In(c.location()), loc)
+	return c
 }
 
 // Now 当前
 func Now() Carbon {
-	return newCarbon(time.Now())
+	return newCarbon(Carbon{}.now())
 }
 
 // Now 当前(指定时区)
 func (c Carbon) Now() Carbon {
-	return newCarbon(Now().Time.In(c.loc))
+	return c.inLocation(newCarbon(c.now()))
 }
 
 // Tomorrow 明天
 func Tomorrow() Carbon {
-	return newCarbon(time.Now().AddDate(0, 0, 1))
+	return newCarbon(Carbon{}.now().AddDate(0, 0, 1))
 }
 
 // Tomorrow 明天(指定时区)
 func (c Carbon) Tomorrow() Carbon {
-	return newCarbon(Tomorrow().Time.In(c.loc))
+	return c.inLocation(newCarbon(c.now().AddDate(0, 0, 1)))
 }
 
 // Yesterday 昨天
 func Yesterday() Carbon {
-	return newCarbon(time.Now().AddDate(0, 0, -1))
+	return newCarbon(Carbon{}.now().AddDate(0, 0, -1))
 }
 
 // Yesterday 昨天(指定时区)
 func (c Carbon) Yesterday() Carbon {
-	return newCarbon(Yesterday().Time.In(c.loc))
+	return c.inLocation(newCarbon(c.now().AddDate(0, 0, -1)))
 }
 
 // CreateFromTimestamp 从时间戳创建Carbon实例
@@ -58,39 +76,71 @@ func CreateFromTimestamp(timestamp int64) Carbon {
 
 // CreateFromTimestamp 从时间戳创建Carbon实例(指定时区)
 func (c Carbon) CreateFromTimestamp(timestamp int64) Carbon {
-	return newCarbon(CreateFromTimestamp(timestamp).Time.In(c.loc))
+	return c.inLocation(CreateFromTimestamp(timestamp))
+}
+
+// CreateFromTimestampMilli 从毫秒级时间戳创建Carbon实例
+func CreateFromTimestampMilli(timestamp int64) Carbon {
+	return newCarbon(time.Unix(timestamp/MillisecondsPerSecond, timestamp%MillisecondsPerSecond*int64(time.Millisecond)))
+}
+
+// CreateFromTimestampMilli 从毫秒级时间戳创建Carbon实例(指定时区)
+func (c Carbon) CreateFromTimestampMilli(timestamp int64) Carbon {
+	return c.inLocation(CreateFromTimestampMilli(timestamp))
+}
+
+// CreateFromTimestampMicro 从微秒级时间戳创建Carbon实例
+func CreateFromTimestampMicro(timestamp int64) Carbon {
+	return newCarbon(time.Unix(timestamp/MicrosecondsPerSecond, timestamp%MicrosecondsPerSecond*int64(time.Microsecond)))
+}
+
+// CreateFromTimestampMicro 从微秒级时间戳创建Carbon实例(指定时区)
+func (c Carbon) CreateFromTimestampMicro(timestamp int64) Carbon {
+	return c.inLocation(CreateFromTimestampMicro(timestamp))
+}
+
+// CreateFromTimestampNano 从纳秒级时间戳创建Carbon实例
+func CreateFromTimestampNano(timestamp int64) Carbon {
+	return newCarbon(time.Unix(0, timestamp))
+}
+
+// CreateFromTimestampNano 从纳秒级时间戳创建Carbon实例(指定时区)
+func (c Carbon) CreateFromTimestampNano(timestamp int64) Carbon {
+	return c.inLocation(CreateFromTimestampNano(timestamp))
 }
 
 // CreateFromDateTime 从年月日时分秒创建Carbon实例
 func CreateFromDateTime(year int, month int, day int, hour int, minute int, second int) Carbon {
-	return newCarbon(time.Date(year, time.Month(month), day, hour, minute, second, 0, getLocalByTimezone(Local)))
+	return newCarbon(time.Date(year, time.Month(month), day, hour, minute, second, 0, time.Local))
 }
 
 // CreateFromDateTime 从年月日时分秒创建Carbon实例(指定时区)
 func (c Carbon) CreateFromDateTime(year int, month int, day int, hour int, minute int, second int) Carbon {
-	return newCarbon(CreateFromDateTime(year, month, day, hour, minute, second).Time.In(c.loc))
+	return c.inLocation(CreateFromDateTime(year, month, day, hour, minute, second))
 }
 
 // CreateFromDate 从年月日创建Carbon实例
 func CreateFromDate(year int, month int, day int) Carbon {
-	hour, minute, second := time.Now().Clock()
-	return newCarbon(time.Date(year, time.Month(month), day, hour, minute, second, 0, getLocalByTimezone(Local)))
+	hour, minute, second := Carbon{}.now().Clock()
+	return CreateFromDateTime(year, month, day, hour, minute, second)
 }
 
 // CreateFromDate 从年月日创建Carbon实例(指定时区)
 func (c Carbon) CreateFromDate(year int, month int, day int) Carbon {
-	return newCarbon(CreateFromDate(year, month, day).Time.In(c.loc))
+	hour, minute, second := c.now().Clock()
+	return c.inLocation(CreateFromDateTime(year, month, day, hour, minute, second))
 }
 
 // CreateFromTime 从时分秒创建Carbon实例
 func CreateFromTime(hour int, minute int, second int) Carbon {
-	year, month, day := time.Now().Date()
-	return newCarbon(time.Date(year, month, day, hour, minute, second, 0, getLocalByTimezone(Local)))
+	year, month, day := Carbon{}.now().Date()
+	return CreateFromDateTime(year, int(month), day, hour, minute, second)
 }
 
 // CreateFromTime 从时分秒创建Carbon实例(指定时区)
 func (c Carbon) CreateFromTime(hour int, minute int, second int) Carbon {
-	return newCarbon(CreateFromTime(hour, minute, second).Time.In(c.loc))
+	year, month, day := c.now().Date()
+	return c.inLocation(CreateFromDateTime(year, int(month), day, hour, minute, second))
 }
 
 // CreateFromGoTime 从原生time.Time创建Carbon实例
@@ -100,97 +150,112 @@ func CreateFromGoTime(t time.Time) Carbon {
 
 // CreateFromGoTime 从原生time.Time创建Carbon实例(指定时区)
 func (c Carbon) CreateFromGoTime(t time.Time) Carbon {
-	return newCarbon(CreateFromGoTime(t).Time.In(c.loc))
+	return c.inLocation(CreateFromGoTime(t))
 }
 
 // Parse 解析标准格式时间字符串
 func Parse(value string) Carbon {
-	layout := DateTimeFormat
-
-	if value == "" || value == "0" || value == "0000-00-00 00:00:00" || value == "0000-00-00" || value == "00:00:00" {
-		return Carbon{loc: getLocalByTimezone(Local)}
-	}
-
-	if len(value) == 10 && strings.Count(value, "-") == 2 {
-		layout = DateFormat
+	if isZeroValue(value) {
+		return Carbon{loc: time.Local}
 	}
 
-	if len(value) == 14 {
-		layout = ShortDateTimeFormat
+	t, err := parseByLayout(value, guessLayout(value))
+	if err != nil {
+		return Carbon{loc: time.Local, Error: err}
 	}
-
-	if len(value) == 8 {
-		layout = ShortDateFormat
-	}
-
-	if strings.Index(value, "T") == 10 {
-		layout = RFC3339Format
-	}
-
-	return newCarbon(parseByLayout(value, layout))
+	return newCarbon(t)
 }
 
 // Parse 解析标准格式时间字符串(指定时区)
 func (c Carbon) Parse(value string) Carbon {
-	return newCarbon(Parse(value).Time.In(c.loc))
+	return c.inLocation(Parse(value))
 }
 
 // ParseByFormat 解析指定格式时间字符串
 func ParseByFormat(value string, format string) Carbon {
-	value = strings.Trim(value, " ")
-	layout := format2layout(format)
-	return newCarbon(parseByLayout(value, layout))
+	return Carbon{loc: time.Local}.ParseByFormat(value, format)
 }
 
-// ParseByFormat 解析指定格式时间字符串(指定时区)
+// ParseByFormat 解析指定格式时间字符串(指定时区)，月份、星期及上下午按照当前区域解析
+// 不含时区的时间按照当前实例的时区解析，含时区的时间使用解析出的时区
 func (c Carbon) ParseByFormat(value string, format string) Carbon {
-	return newCarbon(ParseByFormat(value, format).Time.In(c.loc))
+	if c.Error != nil {
+		return c
+	}
+	t, err := c.parseByFormat(strings.Trim(value, " "), format)
+	if err != nil {
+		return c.withError(err)
+	}
+	c.setTime(t, t.Location())
+	return c
 }
 
-// ParseByDuration 解析持续时间字符串(基于现在时间)
-// 支持正负整数/浮点数和符号ns(纳秒)、us(微妙)、ms(毫秒)、s(秒)、m(分钟)、h(小时)的组合
+// ParseByDuration 解析持续时间字符串(基于现在时间)，语法参考Duration
 func ParseByDuration(duration string) Carbon {
-	return newCarbon(time.Now().Add(parseByDuration(duration)))
+	return Now().Duration(duration)
 }
 
 // ParseByDuration 解析持续时间字符串(指定时区)
 func (c Carbon) ParseByDuration(duration string) Carbon {
-	return newCarbon(ParseByDuration(duration).Time.In(c.loc))
+	return c.Now().Duration(duration)
 }
 
 // Duration 按照持续时间字符串改变时间(指定时区)
-// 支持正负整数/浮点数和符号ns(纳秒)、us(微妙)、ms(毫秒)、s(秒)、m(分钟)、h(小时)的组合
+// 支持正负整数/浮点数和符号ns(纳秒)、us(微妙)、ms(毫秒)、s(秒)、m(分钟)、h(小时)、d(天)、w(周)、mo(月)、y(年)的组合，如3d12h、1y6mo
+// 数字与单位之间及各部分之间可以有空格，单位也可以是英文或中文单词，如-1 day 2 hours、3天2小时，开头的负号作用于整个持续时间
+// 年、月与NextMonths一致不做月份溢出，天、周按日历天数计算，月、年不支持小数，大写M容易与月份混淆视为无效单位
 func (c Carbon) Duration(duration string) Carbon {
-	c.Time = c.Time.Add(parseByDuration(duration))
-	return c
+	if c.Error != nil {
+		return c
+	}
+	i, err := parseByDuration(duration)
+	if err != nil {
+		return c.withError(err)
+	}
+	return c.AddCarbonInterval(i)
 }
 
 // AddYears N年后
 func (c Carbon) AddYears(years int) Carbon {
+	if c.Error != nil {
+		return c
+	}
 	c.Time = c.Time.AddDate(years, 0, 0)
 	return c
 }
 
 // AddYear 1年后
 func (c Carbon) AddYear() Carbon {
+	if c.Error != nil {
+		return c
+	}
 	c.Time = c.Time.AddDate(1, 0, 0)
 	return c
 }
 
 // SubYears N年前
 func (c Carbon) SubYears(years int) Carbon {
+	if c.Error != nil {
+		return c
+	}
 	c.Time = c.Time.AddDate(-years, 0, 0)
 	return c
 }
 
 // SubYear 1年前
 func (c Carbon) SubYear() Carbon {
+	if c.Error != nil {
+		return c
+	}
 	c.Time = c.Time.AddDate(-1, 0, 0)
 	return c
 }
 
 // AddMonths N月后
 func (c Carbon) AddMonths(months int) Carbon {
+	if c.Error != nil {
+		return c
+	}
 	c.Time = c.Time.AddDate(0, months, 0)
 	return c
 }
@@ -202,6 +267,9 @@ func (c Carbon) AddMonth() Carbon {
 
 // SubMonths N月前
 func (c Carbon) SubMonths(months int) Carbon {
+	if c.Error != nil {
+		return c
+	}
 	c.Time = c.Time.AddDate(0, -months, 0)
 	return c
 }
@@ -213,6 +281,9 @@ func (c Carbon) SubMonth() Carbon {
 
 // AddDays N天后
 func (c Carbon) AddDays(days int) Carbon {
+	if c.Error != nil {
+		return c
+	}
 	c.Time = c.Time.AddDate(0, 0, days)
 	return c
 }
@@ -224,6 +295,9 @@ func (c Carbon) AddDay() Carbon {
 
 // SubDays N天前
 func (c Carbon) SubDays(days int) Carbon {
+	if c.Error != nil {
+		return c
+	}
 	c.Time = c.Time.AddDate(0, 0, -days)
 	return c
 }
@@ -235,6 +309,9 @@ func (c Carbon) SubDay() Carbon {
 
 // AddHours N小时后
 func (c Carbon) AddHours(hours int) Carbon {
+	if c.Error != nil {
+		return c
+	}
 	duration := time.Duration(hours) * time.Hour
 	c.Time = c.Time.Add(duration)
 	return c
@@ -247,6 +324,9 @@ func (c Carbon) AddHour() Carbon {
 
 // SubHours N小时前
 func (c Carbon) SubHours(hours int) Carbon {
+	if c.Error != nil {
+		return c
+	}
 	duration := time.Duration(hours) * -time.Hour
 	c.Time = c.Time.Add(duration)
 	return c
@@ -259,6 +339,9 @@ func (c Carbon) SubHour() Carbon {
 
 // AddMinutes N分钟后
 func (c Carbon) AddMinutes(minutes int) Carbon {
+	if c.Error != nil {
+		return c
+	}
 	duration := time.Duration(minutes) * time.Minute
 	c.Time = c.Time.Add(duration)
 	return c
@@ -271,6 +354,9 @@ func (c Carbon) AddMinute() Carbon {
 
 // SubMinutes N分钟前
 func (c Carbon) SubMinutes(minutes int) Carbon {
+	if c.Error != nil {
+		return c
+	}
 	duration := time.Duration(minutes) * -time.Minute
 	c.Time = c.Time.Add(duration)
 	return c
@@ -283,6 +369,9 @@ func (c Carbon) SubMinute() Carbon {
 
 // AddSeconds N秒钟后
 func (c Carbon) AddSeconds(seconds int) Carbon {
+	if c.Error != nil {
+		return c
+	}
 	duration := time.Duration(seconds) * time.Second
 	c.Time = c.Time.Add(duration)
 	return c
@@ -295,6 +384,9 @@ func (c Carbon) AddSecond() Carbon {
 
 // SubSeconds N秒钟前
 func (c Carbon) SubSeconds(seconds int) Carbon {
+	if c.Error != nil {
+		return c
+	}
 	duration := time.Duration(seconds) * -time.Second
 	c.Time = c.Time.Add(duration)
 	return c
@@ -305,20 +397,113 @@ func (c Carbon) SubSecond() Carbon {
 	return c.SubSeconds(1)
 }
 
+// AddMilliseconds N毫秒后
+func (c Carbon) AddMilliseconds(milliseconds int) Carbon {
+	if c.Error != nil {
+		return c
+	}
+	duration := time.Duration(milliseconds) * time.Millisecond
+	c.Time = c.Time.Add(duration)
+	return c
+}
+
+// AddMillisecond 1毫秒后
+func (c Carbon) AddMillisecond() Carbon {
+	return c.AddMilliseconds(1)
+}
+
+// SubMilliseconds N毫秒前
+func (c Carbon) SubMilliseconds(milliseconds int) Carbon {
+	if c.Error != nil {
+		return c
+	}
+	duration := time.Duration(milliseconds) * -time.Millisecond
+	c.Time = c.Time.Add(duration)
+	return c
+}
+
+// SubMillisecond 1毫秒前
+func (c Carbon) SubMillisecond() Carbon {
+	return c.SubMilliseconds(1)
+}
+
+// AddMicroseconds N微秒后
+func (c Carbon) AddMicroseconds(microseconds int) Carbon {
+	if c.Error != nil {
+		return c
+	}
+	duration := time.Duration(microseconds) * time.Microsecond
+	c.Time = c.Time.Add(duration)
+	return c
+}
+
+// AddMicrosecond 1微秒后
+func (c Carbon) AddMicrosecond() Carbon {
+	return c.AddMicroseconds(1)
+}
+
+// SubMicroseconds N微秒前
+func (c Carbon) SubMicroseconds(microseconds int) Carbon {
+	if c.Error != nil {
+		return c
+	}
+	duration := time.Duration(microseconds) * -time.Microsecond
+	c.Time = c.Time.Add(duration)
+	return c
+}
+
+// SubMicrosecond 1微秒前
+func (c Carbon) SubMicrosecond() Carbon {
+	return c.SubMicroseconds(1)
+}
+
+// AddNanoseconds N纳秒后
+func (c Carbon) AddNanoseconds(nanoseconds int) Carbon {
+	if c.Error != nil {
+		return c
+	}
+	duration := time.Duration(nanoseconds) * time.Nanosecond
+	c.Time = c.Time.Add(duration)
+	return c
+}
+
+// AddNanosecond 1纳秒后
+func (c Carbon) AddNanosecond() Carbon {
+	return c.AddNanoseconds(1)
+}
+
+// SubNanoseconds N纳秒前
+func (c Carbon) SubNanoseconds(nanoseconds int) Carbon {
+	if c.Error != nil {
+		return c
+	}
+	duration := time.Duration(nanoseconds) * -time.Nanosecond
+	c.Time = c.Time.Add(duration)
+	return c
+}
+
+// SubNanosecond 1纳秒前
+func (c Carbon) SubNanosecond() Carbon {
+	return c.SubNanoseconds(1)
+}
+
 // NextYears N年后
 func (c Carbon) NextYears(years int) Carbon {
+	if c.Error != nil {
+		return c
+	}
 	year := c.Time.Year() + years
 	month := c.Time.Month()
 	day := c.Time.Day()
 
 	// 获取N年后本月的最后一天
-	last := time.Date(year, month, 1, c.Time.Hour(), c.Time.Minute(), c.Time.Second(), c.Time.Nanosecond(), c.loc).AddDate(0, 1, -1)
+	last := time.Date(year, month, 1, c.Time.Hour(), c.Time.Minute(), c.Time.Second(), c.Time.Nanosecond(), c.location()).AddDate(0, 1, -1)
 
 	if day > last.Day() {
 		day = last.Day()
 	}
 
-	c.Time = time.Date(last.Year(), last.Month(), day, c.Time.Hour(), c.Time.Minute(), c.Time.Second(), 0, c.loc)
+	c.Time = time.Date(last.Year(), last.Month(), day, c.Time.Hour(), c.Time.Minute(), c.Time.Second(), c.Time.Nanosecond(), c.location())
 	return c
 }
 
@@ -329,18 +514,21 @@ func (c Carbon) NextYear() Carbon {
 
 // N月后
 func (c Carbon) NextMonths(months int) Carbon {
+	if c.Error != nil {
+		return c
+	}
 	year := c.Time.Year()
 	month := c.Time.Month() + time.Month(months)
 	day := c.Time.Day()
 
 	// 获取N月后的最后一天
-	last := time.Date(year, month, 1, c.Time.Hour(), c.Time.Minute(), c.Time.Second(), c.Time.Nanosecond(), c.loc).AddDate(0, 1, -1)
+	last := time.Date(year, month, 1, c.Time.Hour(), c.Time.Minute(), c.Time.Second(), c.Time.Nanosecond(), c.location()).AddDate(0, 1, -1)
 
 	if day > last.Day() {
 		day = last.Day()
 	}
 
-	c.Time = time.Date(last.Year(), last.Month(), day, c.Time.Hour(), c.Time.Minute(), c.Time.Second(), 0, c.loc)
+	c.Time = time.Date(last.Year(), last.Month(), day, c.Time.Hour(), c.Time.Minute(), c.Time.Second(), c.Time.Nanosecond(), c.location())
 	return c
 }
 
@@ -371,83 +559,110 @@ func (c Carbon) PreMonth() Carbon {
 
 // BeginningOfYear 本年开始时间
 func (c Carbon) BeginningOfYear() Carbon {
-	c.Time = time.Date(c.Time.Year(), 1, 1, 0, 0, 0, 0, c.loc)
+	if c.Error != nil {
+		return c
+	}
+	c.Time = time.Date(c.Time.Year(), 1, 1, 0, 0, 0, 0, c.location())
 	return c
 }
 
 // EndOfYear 本年结束时间
 func (c Carbon) EndOfYear() Carbon {
-	c.Time = time.Date(c.Time.Year(), 12, 31, 23, 59, 59, 0, c.loc)
+	if c.Error != nil {
+		return c
+	}
+	c.Time = time.Date(c.Time.Year(), 12, 31, 23, 59, 59, maxNanosecond, c.location())
 	return c
 }
 
 // BeginningOfMonth 本月开始时间
 func (c Carbon) BeginningOfMonth() Carbon {
-	c.Time = time.Date(c.Time.Year(), c.Time.Month(), 1, 0, 0, 0, 0, c.loc)
+	if c.Error != nil {
+		return c
+	}
+	c.Time = time.Date(c.Time.Year(), c.Time.Month(), 1, 0, 0, 0, 0, c.location())
 	return c
 }
 
 // EndOfMonth 本月结束时间
 func (c Carbon) EndOfMonth() Carbon {
-	t := time.Date(c.Time.Year(), c.Time.Month(), 1, 23, 59, 59, 0, c.loc)
+	if c.Error != nil {
+		return c
+	}
+	t := time.Date(c.Time.Year(), c.Time.Month(), 1, 23, 59, 59, maxNanosecond, c.location())
 	c.Time = t.AddDate(0, 1, -1)
 	return c
 }
 
-// BeginningOfWeek 本周开始时间
+// BeginningOfWeek 本周开始时间(按照每周起始日计算，默认为周一)
 func (c Carbon) BeginningOfWeek() Carbon {
-	days := c.Time.Weekday()
-	if days == 0 {
-		days = DaysPerWeek
+	if c.Error != nil {
+		return c
 	}
-	t := time.Date(c.Time.Year(), c.Time.Month(), c.Time.Day(), 0, 0, 0, 0, c.loc)
-	c.Time = t.AddDate(0, 0, int(1-days))
+	c.Time = time.Date(c.Time.Year(), c.Time.Month(), c.Time.Day()-c.dayOfWeek(c.Time), 0, 0, 0, 0, c.location())
 	return c
 }
 
-// EndOfWeek 本周结束时间
+// EndOfWeek 本周结束时间(按照每周起始日计算，默认为周日)
 func (c Carbon) EndOfWeek() Carbon {
-	days := c.Time.Weekday()
-	if days == 0 {
-		days = DaysPerWeek
+	if c.Error != nil {
+		return c
 	}
-	t := time.Date(c.Time.Year(), c.Time.Month(), c.Time.Day(), 23, 59, 59, 0, c.loc)
-	c.Time = t.AddDate(0, 0, int(DaysPerWeek-days))
+	day := c.Time.Day() + DaysPerWeek - 1 - c.dayOfWeek(c.Time)
+	c.Time = time.Date(c.Time.Year(), c.Time.Month(), day, 23, 59, 59, maxNanosecond, c.location())
 	return c
 }
 
 // BeginningOfDay 本日开始时间
 func (c Carbon) BeginningOfDay() Carbon {
-	c.Time = time.Date(c.Time.Year(), c.Time.Month(), c.Time.Day(), 0, 0, 0, 0, c.loc)
+	if c.Error != nil {
+		return c
+	}
+	c.Time = time.Date(c.Time.Year(), c.Time.Month(), c.Time.Day(), 0, 0, 0, 0, c.location())
 	return c
 }
 
 // EndOfDay 本日结束时间
 func (c Carbon) EndOfDay() Carbon {
-	c.Time = time.Date(c.Time.Year(), c.Time.Month(), c.Time.Day(), 23, 59, 59, 0, c.loc)
+	if c.Error != nil {
+		return c
+	}
+	c.Time = time.Date(c.Time.Year(), c.Time.Month(), c.Time.Day(), 23, 59, 59, maxNanosecond, c.location())
 	return c
 }
 
 // BeginningOfHour 小时开始时间
 func (c Carbon) BeginningOfHour() Carbon {
-	c.Time = time.Date(c.Time.Year(), c.Time.Month(), c.Time.Day(), c.Time.Hour(), 0, 0, 0, c.loc)
+	if c.Error != nil {
+		return c
+	}
+	c.Time = time.Date(c.Time.Year(), c.Time.Month(), c.Time.Day(), c.Time.Hour(), 0, 0, 0, c.location())
 	return c
 }
 
 // EndOfHour 小时结束时间
 func (c Carbon) EndOfHour() Carbon {
-	c.Time = time.Date(c.Time.Year(), c.Time.Month(), c.Time.Day(), c.Time.Hour(), 59, 59, 0, c.loc)
+	if c.Error != nil {
+		return c
+	}
+	c.Time = time.Date(c.Time.Year(), c.Time.Month(), c.Time.Day(), c.Time.Hour(), 59, 59, maxNanosecond, c.location())
 	return c
 }
 
 // BeginningOfMinute 分钟开始时间
 func (c Carbon) BeginningOfMinute() Carbon {
-	c.Time = time.Date(c.Time.Year(), c.Time.Month(), c.Time.Day(), c.Time.Hour(), c.Time.Minute(), 0, 0, c.loc)
+	if c.Error != nil {
+		return c
+	}
+	c.Time = time.Date(c.Time.Year(), c.Time.Month(), c.Time.Day(), c.Time.Hour(), c.Time.Minute(), 0, 0, c.location())
 	return c
 }
 
 // EndOfMinute 分钟结束时间
 func (c Carbon) EndOfMinute() Carbon {
-	c.Time = time.Date(c.Time.Year(), c.Time.Month(), c.Time.Day(), c.Time.Hour(), c.Time.Minute(), 59, 0, c.loc)
+	if c.Error != nil {
+		return c
+	}
+	c.Time = time.Date(c.Time.Year(), c.Time.Month(), c.Time.Day(), c.Time.Hour(), c.Time.Minute(), 59, maxNanosecond, c.location())
 	return c
 }
@@ -1,7 +1,6 @@
 package carbon
 
 import (
-	"fmt"
 	"testing"
 	"time"
 )
@@ -13,16 +12,10 @@ var TimezoneTests = []struct {
 }{
 	{"2020-08-05 13:14:15", PRC, "2020-08-05 13:14:15"},
 	{"2020-08-05", Tokyo, "2020-08-05 01:00:00"},
-	{"2020-08-05", "Hangzhou", "panic"}, // 异常情况
+	{"2020-08-05", "Hangzhou", ""}, // 异常情况
 }
 
 func TestCarbon_Timezone1(t *testing.T) {
-	defer func() {
-		if r := recover(); r != nil {
-			fmt.Printf("catch an exception in Timezone()：%s\n", r)
-		}
-	}()
-
 	for _, v := range TimezoneTests {
 		output := Timezone(v.timezone).Parse(v.input).ToDateTimeString()
 
@@ -33,12 +26,6 @@ func TestCarbon_Timezone1(t *testing.T) {
 }
 
 func TestCarbon_Timezone2(t *testing.T) {
-	defer func() {
-		if r := recover(); r != nil {
-			fmt.Printf("catch an exception in Timezone()：%s\n", r)
-		}
-	}()
-
 	for _, v := range TimezoneTests {
 		output := Timezone(PRC).Timezone(v.timezone).Parse(v.input).ToDateTimeString()
 
@@ -48,6 +35,91 @@ func TestCarbon_Timezone2(t *testing.T) {
 	}
 }
 
+func TestCarbon_Error(t *testing.T) {
+	Tests := []struct {
+		input  Carbon // 输入值
+		output string // 期望输出值
+	}{
+		{Timezone("Hangzhou"), `invalid timezone "Hangzhou", all valid timezone, please see the $GOROOT/lib/time/zoneinfo.zip file`},
+		{Timezone(PRC).Timezone("Hangzhou").Now(), `invalid timezone "Hangzhou", all valid timezone, please see the $GOROOT/lib/time/zoneinfo.zip file`},
+		{Timezone("Hangzhou").Parse("2020-08-05").AddDays(3).EndOfMonth(), `invalid timezone "Hangzhou", all valid timezone, please see the $GOROOT/lib/time/zoneinfo.zip file`},
+		{Parse("12345678").AddDays(3).Timezone("Hangzhou"), `the value "12345678" and layout "20060102" don't match`},
+		{ParseByFormat("2020-08-05", "Y|m|d").NextMonth(), `the value "2020-08-05" and format "Y|m|d" don't match`},
+		{ParseByDuration("10x").SubHours(1), `invalid duration "10x"`},
+		{Parse("2020-08-05").Duration("10x").BeginningOfDay(), `invalid duration "10x"`},
+	}
+
+	for _, v := range Tests {
+		if v.input.Error == nil {
+			t.Fatalf("Expected error %s, but got nil", v.output)
+		}
+
+		if v.input.Error.Error() != v.output {
+			t.Fatalf("Expected error %s, but got %s", v.output, v.input.Error.Error())
+		}
+
+		if output := v.input.ToDateTimeString(); output != "" {
+			t.Fatalf("Expected empty string, but got %s", output)
+		}
+	}
+
+	if c := Timezone(PRC).Parse("2020-08-05 13:14:15").AddDays(3); c.Error != nil {
+		t.Fatalf("Expected nil error, but got %s", c.Error)
+	}
+
+	// 出错时保留实例的区域、一周的开始日期及财年开始月份等设置
+	c := Timezone(Tokyo).Locale(SimplifiedChinese).SetWeekStartsAt(time.Sunday).SetFiscalYearStartMonth(4)
+	for _, e := range []Carbon{
+		c.Timezone("Hangzhou"),
+		c.Locale("xx"),
+		c.Duration("10x"),
+		c.AddISODuration("xxx"),
+		c.Parse("xxx"),
+		c.SetWeekStartsAt(7),
+		c.SetFiscalYearStartMonth(13),
+		c.SetGanZhiYearBoundary(0),
+		c.Parse("2100-12-25").NextSolarTerm(),
+	} {
+		if e.Error == nil || !e.IsZero() || e.GetLocale() != SimplifiedChinese || e.GetWeekStartsAt() != time.Sunday || e.GetFiscalYearStartMonth() != 4 || e.loc.String() != Tokyo {
+			t.Fatalf("Expected error and settings to be kept, but got %v %s %s %d %s", e.Error, e.GetLocale(), e.GetWeekStartsAt(), e.GetFiscalYearStartMonth(), e.loc)
+		}
+	}
+}
+
+func TestCarbon_ZeroValue(t *testing.T) {
+	// 零值实例未设置时区，按照本地时区处理
+	var c Carbon
+	Tests := []struct {
+		input  Carbon // 输入值
+		output string // 期望输出值
+	}{
+		{c.Parse("2020-08-05"), "2020-08-05 00:00:00"},
+		{c.Parse("2020-08-05").EndOfMonth(), "2020-08-31 23:59:59"},
+		{c.Parse("2020-08-05").NextMonths(1).BeginningOfQuarter(), "2020-07-01 00:00:00"},
+		{c.CreateFromDateTime(2020, 8, 5, 13, 14, 15), "2020-08-05 13:14:15"},
+	}
+
+	for _, v := range Tests {
+		if v.input.Error != nil {
+			t.Fatalf("Expected %s, but got error %v\n", v.output, v.input.Error)
+		}
+
+		if output := v.input.ToFormatString("Y-m-d H:i:s"); output != v.output {
+			t.Fatalf("Expected %s, but got %s\n", v.output, output)
+		}
+	}
+
+	for _, output := range []Carbon{c.EndOfMonth(), c.BeginningOfYear(), c.NextYears(1), c.EndOfQuarter()} {
+		if output.Error != nil {
+			t.Fatalf("Expected nil error, but got %v\n", output.Error)
+		}
+	}
+	local := c.Time.In(time.Local)
+	if c.ToFormatString("Y-m-d") != "" || c.IsJanuary() != (local.Month() == time.January) || c.IsMonday() != (local.Weekday() == time.Monday) {
+		t.Fatal("Expected the zero value to use the local timezone\n")
+	}
+}
+
 func TestCarbon_Now(t *testing.T) {
 	expected := time.Now().Format(DateTimeFormat)
 
@@ -351,6 +423,77 @@ func TestCarbon_EndOfMinute(t *testing.T) {
 	}
 }
 
+func TestCarbon_CreateFromTimestampWithPrecision(t *testing.T) {
+	Tests := []struct {
+		carbon Carbon // 输入值
+		output string // 期望输出值
+	}{
+		{CreateFromTimestampMilli(1596604455123), "2020-08-05 13:14:15.123000000"},
+		{CreateFromTimestampMicro(1596604455123456), "2020-08-05 13:14:15.123456000"},
+		{CreateFromTimestampNano(1596604455123456789), "2020-08-05 13:14:15.123456789"},
+		{CreateFromTimestampMilli(-1), "1970-01-01 07:59:59.999000000"},
+		{Timezone(Tokyo).CreateFromTimestampMilli(1596604455123), "2020-08-05 14:14:15.123000000"},
+		{Timezone(Tokyo).CreateFromTimestampMicro(1596604455123456), "2020-08-05 14:14:15.123456000"},
+		{Timezone(Tokyo).CreateFromTimestampNano(1596604455123456789), "2020-08-05 14:14:15.123456789"},
+	}
+
+	for _, v := range Tests {
+		output := v.carbon.Time.Format("2006-01-02 15:04:05.000000000")
+
+		if output != v.output {
+			t.Fatalf("Expected %s, but got %s\n", v.output, output)
+		}
+	}
+}
+
+func TestCarbon_SubSecondArithmetic(t *testing.T) {
+	c := CreateFromTimestampNano(1596604455123456789)
+	Tests := []struct {
+		carbon Carbon // 输入值
+		output string // 期望输出值
+	}{
+		{c.AddMilliseconds(900), "2020-08-05 13:14:16.023456789"},
+		{c.AddMillisecond(), "2020-08-05 13:14:15.124456789"},
+		{c.SubMilliseconds(200), "2020-08-05 13:14:14.923456789"},
+		{c.SubMillisecond(), "2020-08-05 13:14:15.122456789"},
+		{c.AddMicroseconds(544), "2020-08-05 13:14:15.124000789"},
+		{c.AddMicrosecond(), "2020-08-05 13:14:15.123457789"},
+		{c.SubMicroseconds(456), "2020-08-05 13:14:15.123000789"},
+		{c.SubMicrosecond(), "2020-08-05 13:14:15.123455789"},
+		{c.AddNanoseconds(211), "2020-08-05 13:14:15.123457000"},
+		{c.AddNanosecond(), "2020-08-05 13:14:15.123456790"},
+		{c.SubNanoseconds(789), "2020-08-05 13:14:15.123456000"},
+		{c.SubNanosecond(), "2020-08-05 13:14:15.123456788"},
+		{c.NextYears(1), "2021-08-05 13:14:15.123456789"},
+		{c.NextMonths(1), "2020-09-05 13:14:15.123456789"},
+		{c.PreMonths(6), "2020-02-05 13:14:15.123456789"},
+		{c.AddDays(1), "2020-08-06 13:14:15.123456789"},
+		{c.BeginningOfDay(), "2020-08-05 00:00:00.000000000"},
+		{c.EndOfYear(), "2020-12-31 23:59:59.999999999"},
+		{c.EndOfMonth(), "2020-08-31 23:59:59.999999999"},
+		{c.EndOfWeek(), "2020-08-09 23:59:59.999999999"},
+		{c.EndOfDay(), "2020-08-05 23:59:59.999999999"},
+		{c.EndOfHour(), "2020-08-05 13:59:59.999999999"},
+		{c.EndOfMinute(), "2020-08-05 13:14:59.999999999"},
+	}
+
+	for i, v := range Tests {
+		output := v.carbon.Time.Format("2006-01-02 15:04:05.000000000")
+
+		if output != v.output {
+			t.Fatalf("Case %d, expected %s, but got %s\n", i, v.output, output)
+		}
+	}
+
+	if !Parse("2020-08-05 23:59:59").Lt(Parse("2020-08-05").EndOfDay()) {
+		t.Fatal("Expected the end of day to include the last second\n")
+	}
+
+	if Parse("xxx").AddMilliseconds(1).Error == nil || Parse("xxx").SubNanoseconds(1).Error == nil {
+		t.Fatal("Expected error to be passed along the chain\n")
+	}
+}
+
 func TestCarbon_CreateFromTimestamp(t *testing.T) {
 	Tests := []struct {
 		timestamp int64  // 输入参数
@@ -476,7 +619,7 @@ func TestCarbon_CreateFromGoTime(t *testing.T) {
 		output string    // 期望输出值
 	}{
 		{time.Now(), time.Now().Format(DateTimeFormat)},
-		{parseByLayout("2020-08-05 13:14:15", DateTimeFormat), "2020-08-05 13:14:15"},
+		{time.Date(2020, 8, 5, 13, 14, 15, 0, time.Local), "2020-08-05 13:14:15"},
 	}
 
 	for _, v := range Tests {
@@ -506,15 +649,9 @@ func TestCarbon_Parse(t *testing.T) {
 		{"20200805", "2020-08-05 00:00:00"},
 		{"2020-08-05", "2020-08-05 00:00:00"},
 		{"2020-08-05T13:14:15+08:00", "2020-08-05 13:14:15"},
-		{"12345678", "panic"}, // 异常情况
+		{"12345678", ""}, // 异常情况
 	}
 
-	defer func() {
-		if r := recover(); r != nil {
-			fmt.Printf("catch an exception in Parse()：%s\n", r)
-		}
-	}()
-
 	for _, v := range Tests {
 		output := Parse(v.input).ToDateTimeString()
 
@@ -539,16 +676,25 @@ var ParseByFormatTests = []struct {
 }{
 	{"2020|08|05", "Y|m|d", "2020-08-05 00:00:00"},
 	{"2020|08|05 13:14:15", "Y|m|d H:i:s", "2020-08-05 13:14:15"},
-	{"12345678", "abc", "panic"}, // 异常情况
+	{"2020年08月05日 13时14分15秒", "Y年m月d日 H时i分s秒", "2020-08-05 13:14:15"},
+	{"2020-08-05 at 13:14", "Y-m-d \\a\\t H:i", "2020-08-05 13:14:00"},
+	{"20200805 2020", "Ymd Y", "2020-08-05 00:00:00"},
+	{"Wednesday, 5th August 2020 1:14:15 pm", "l, jS F Y g:i:s a", "2020-08-05 13:14:15"},
+	{"Wed, Aug 5 20 12:14 AM", "D, M j y h:i A", "2020-08-05 00:14:00"},
+	{"2020-8-5 9:04:05", "Y-n-j G:i:s", "2020-08-05 09:04:05"},
+	{"2020-08-05 13:14:15.999999", "Y-m-d H:i:s.u", "2020-08-05 13:14:15"},
+	{"2020 217", "Y z", "2020-08-05 00:00:00"},
+	{"2020-08-05T13:14:15+08:00", "c", "2020-08-05 13:14:15"},
+	{"Wed, 05 Aug 2020 13:14:15 +0800", "r", "2020-08-05 13:14:15"},
+	{"1596604455", "U", "2020-08-05 13:14:15"},
+	{"12345678", "abc", ""},                    // 异常情况
+	{"2020-02-30", "Y-m-d", ""},                // 异常情况
+	{"2020-08-05 13:14", "Y-m-d H:i:s", ""},    // 异常情况
+	{"2020-08-05 13:14:15", "Y-m-d H:i", ""},   // 异常情况
+	{"2020-08-05 13:14:15", "Y-m-d h:i:s", ""}, // 异常情况
 }
 
 func TestCarbon_ParseByFormat1(t *testing.T) {
-	defer func() {
-		if r := recover(); r != nil {
-			fmt.Printf("catch an exception in ParseByFormat()：%s\n", r)
-		}
-	}()
-
 	for _, v := range ParseByFormatTests {
 		output := ParseByFormat(v.input, v.format).ToDateTimeString()
 
@@ -559,12 +705,6 @@ func TestCarbon_ParseByFormat1(t *testing.T) {
 }
 
 func TestCarbon_ParseByFormat2(t *testing.T) {
-	defer func() {
-		if r := recover(); r != nil {
-			fmt.Printf("catch an exception in ParseByFormat()：%s\n", r)
-		}
-	}()
-
 	for _, v := range ParseByFormatTests {
 		output := Timezone(PRC).ParseByFormat(v.input, v.format).ToDateTimeString()
 
@@ -574,36 +714,80 @@ func TestCarbon_ParseByFormat2(t *testing.T) {
 	}
 }
 
+func TestCarbon_ParseByFormat3(t *testing.T) {
+	Tests := []struct {
+		input  string // 输入值
+		format string // 输入参数
+		output string // 期望输出值
+	}{
+		{"2020-08-05T05:14:15Z", "Y-m-d\\TH:i:sO", "2020-08-05 05:14:15"},
+		{"2020-08-05 07:14:15 +0200", "Y-m-d H:i:s O", "2020-08-05 07:14:15"},
+		{"2020-08-05 13:14:15 +08:00", "Y-m-d H:i:s O", "2020-08-05 13:14:15"},
+		{"2020-08-04 21:14:15 -28800", "Y-m-d H:i:s Z", "2020-08-04 21:14:15"},
+		{"2020-08-05 05:14:15 UTC", "Y-m-d H:i:s T", "2020-08-05 05:14:15"},
+		{"2020-08-05 14:14:15 Asia/Tokyo", "Y-m-d H:i:s e", "2020-08-05 14:14:15"},
+	}
+
+	for _, v := range Tests {
+		c := ParseByFormat(v.input, v.format)
+		output := c.ToDateTimeString()
+
+		if output != v.output || output != c.ToFormatString("Y-m-d H:i:s") {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.output, output)
+		}
+
+		if c.ToTimestamp() != 1596604455 {
+			t.Fatalf("Input %s, expected timestamp %d, but got %d\n", v.input, 1596604455, c.ToTimestamp())
+		}
+	}
+}
+
+func TestCarbon_ParseByFormat4(t *testing.T) {
+	// 解析出的时区用于输出
+	if output := ParseByFormat("2020-08-05 05:14:15 Asia/Tokyo", "Y-m-d H:i:s e").ToFormatString("Y-m-d H:i:s e"); output != "2020-08-05 05:14:15 Asia/Tokyo" {
+		t.Fatalf("Expected 2020-08-05 05:14:15 Asia/Tokyo, but got %s\n", output)
+	}
+
+	// 不含时区的时间按照当前实例的时区解析
+	if output := Timezone(Tokyo).ParseByFormat("2020-08-05 05:14:15", "Y-m-d H:i:s").ToFormatString("Y-m-d H:i:s e"); output != "2020-08-05 05:14:15 Asia/Tokyo" {
+		t.Fatalf("Expected 2020-08-05 05:14:15 Asia/Tokyo, but got %s\n", output)
+	}
+
+	// 按照当前实例的区域解析
+	c := Timezone(PRC).Locale(SimplifiedChinese).ParseByFormat("2020年八月5日 下午1点", "Y年Fj日 Ag点")
+	if c.Error != nil || c.ToDateTimeString() != "2020-08-05 13:00:00" || c.GetLocale() != SimplifiedChinese {
+		t.Fatalf("Expected 2020-08-05 13:00:00, but got %s %v\n", c.ToDateTimeString(), c.Error)
+	}
+
+	if c := Timezone(PRC).Locale(SimplifiedChinese).ParseByFormat("xxx", "Y"); c.Error == nil || c.GetLocale() != SimplifiedChinese {
+		t.Fatal("Expected error and locale to be kept\n")
+	}
+}
+
 var ParseByDurationTests = []struct {
 	input    string // 输入值
 	duration string // 输入参数
 	output   string // 期望输出值
 }{
-	{Now().ToDateTimeString(), "10h", time.Now().Add(parseByDuration("10h")).Format(DateTimeFormat)},
-	{Now().ToDateTimeString(), "-10h", time.Now().Add(parseByDuration("-10h")).Format(DateTimeFormat)},
-	{Now().ToDateTimeString(), "10.5h", time.Now().Add(parseByDuration("10.5h")).Format(DateTimeFormat)},
-	{Now().ToDateTimeString(), "-10.5h", time.Now().Add(parseByDuration("-10.5h")).Format(DateTimeFormat)},
-
-	{Now().ToDateTimeString(), "10m", time.Now().Add(parseByDuration("10m")).Format(DateTimeFormat)},
-	{Now().ToDateTimeString(), "-10m", time.Now().Add(parseByDuration("-10m")).Format(DateTimeFormat)},
-	{Now().ToDateTimeString(), "10.5m", time.Now().Add(parseByDuration("10.5m")).Format(DateTimeFormat)},
-	{Now().ToDateTimeString(), "-10.5m", time.Now().Add(parseByDuration("-10.5m")).Format(DateTimeFormat)},
-
-	{Now().ToDateTimeString(), "10s", time.Now().Add(parseByDuration("10s")).Format(DateTimeFormat)},
-	{Now().ToDateTimeString(), "-10s", time.Now().Add(parseByDuration("-10s")).Format(DateTimeFormat)},
-	{Now().ToDateTimeString(), "10.5s", time.Now().Add(parseByDuration("10.5s")).Format(DateTimeFormat)},
-	{Now().ToDateTimeString(), "-10.5s", time.Now().Add(parseByDuration("-10.5s")).Format(DateTimeFormat)},
-
-	{Now().ToDateTimeString(), "-10a", "panic"}, // 异常情况
+	{Now().ToDateTimeString(), "10h", time.Now().Add(10 * time.Hour).Format(DateTimeFormat)},
+	{Now().ToDateTimeString(), "-10h", time.Now().Add(-10 * time.Hour).Format(DateTimeFormat)},
+	{Now().ToDateTimeString(), "10.5h", time.Now().Add(630 * time.Minute).Format(DateTimeFormat)},
+	{Now().ToDateTimeString(), "-10.5h", time.Now().Add(-630 * time.Minute).Format(DateTimeFormat)},
+
+	{Now().ToDateTimeString(), "10m", time.Now().Add(10 * time.Minute).Format(DateTimeFormat)},
+	{Now().ToDateTimeString(), "-10m", time.Now().Add(-10 * time.Minute).Format(DateTimeFormat)},
+	{Now().ToDateTimeString(), "10.5m", time.Now().Add(630 * time.Second).Format(DateTimeFormat)},
+	{Now().ToDateTimeString(), "-10.5m", time.Now().Add(-630 * time.Second).Format(DateTimeFormat)},
+
+	{Now().ToDateTimeString(), "10s", time.Now().Add(10 * time.Second).Format(DateTimeFormat)},
+	{Now().ToDateTimeString(), "-10s", time.Now().Add(-10 * time.Second).Format(DateTimeFormat)},
+	{Now().ToDateTimeString(), "10.5s", time.Now().Add(10500 * time.Millisecond).Format(DateTimeFormat)},
+	{Now().ToDateTimeString(), "-10.5s", time.Now().Add(-10500 * time.Millisecond).Format(DateTimeFormat)},
+
+	{Now().ToDateTimeString(), "-10a", ""}, // 异常情况
 }
 
 func TestCarbon_ParseByDuration1(t *testing.T) {
-	defer func() {
-		if r := recover(); r != nil {
-			fmt.Printf("catch an exception in ParseByDuration()：%s\n", r)
-		}
-	}()
-
 	for _, v := range ParseByDurationTests {
 		output := ParseByDuration(v.duration).ToDateTimeString()
 
@@ -614,12 +798,6 @@ func TestCarbon_ParseByDuration1(t *testing.T) {
 }
 
 func TestCarbon_ParseByDuration2(t *testing.T) {
-	defer func() {
-		if r := recover(); r != nil {
-			fmt.Printf("catch an exception in ParseByDuration()：%s\n", r)
-		}
-	}()
-
 	for _, v := range ParseByDurationTests {
 		output := Timezone(PRC).ParseByDuration(v.duration).ToDateTimeString()
 
@@ -650,15 +828,31 @@ func TestCarbon_Duration(t *testing.T) {
 		{"2020-01-01 13:14:15", "10.5s", "2020-01-01 13:14:25"},
 		{"2020-01-01 13:14:15", "-10.5s", "2020-01-01 13:14:04"},
 
+		{"2020-01-01 13:14:15", "3d12h", "2020-01-05 01:14:15"},
+		{"2020-01-01 13:14:15", "1.5d", "2020-01-03 01:14:15"},
+		{"2020-01-01 13:14:15", "2w", "2020-01-15 13:14:15"},
+		{"2020-01-31 13:14:15", "1mo", "2020-02-29 13:14:15"},
+		{"2020-02-29 13:14:15", "1y6mo", "2021-08-29 13:14:15"},
+		{"2020-02-29 13:14:15", "-1y", "2019-02-28 13:14:15"},
+		{"2020-01-01 13:14:15", "1d-1h", "2020-01-02 12:14:15"},
+		{"2020-01-01 13:14:15", "-1 day 2 hours", "2019-12-31 11:14:15"},
+		{"2020-01-01 13:14:15", " 1 Year 2 Months 3 days ", "2021-03-04 13:14:15"},
+		{"2020-01-01 13:14:15", "2 weeks 30 mins", "2020-01-15 13:44:15"},
+		{"2020-01-01 13:14:15", "3天2小时", "2020-01-04 15:14:15"},
+		{"2020-01-31 13:14:15", "1年1个月", "2021-02-28 13:14:15"},
+		{"2020-01-01 13:14:15", "2周 30分钟 10秒", "2020-01-15 13:44:25"},
+		{"2020-01-01 13:14:15", "0", "2020-01-01 13:14:15"},
+
 		{"2020-01-01 13:14:15", "-10x", ""},
+		{"2020-01-01 13:14:15", "", ""},
+		{"2020-01-01 13:14:15", "1", ""},
+		{"2020-01-01 13:14:15", "day", ""},
+		{"2020-01-01 13:14:15", "1.5mo", ""},
+		{"2020-01-01 13:14:15", "1..5h", ""},
+		{"2020-01-01 13:14:15", "1M", ""},
+		{"2020-01-01 13:14:15", "99999999999999h", ""},
 	}
 
-	defer func() {
-		if r := recover(); r != nil {
-			fmt.Printf("catch an exception in Duration()：%s\n", r)
-		}
-	}()
-
 	for _, v := range Tests {
 		output := Parse(v.input).Duration(v.duration).ToDateTimeString()
 
@@ -0,0 +1,207 @@
+package carbon
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CarbonInterval 日历时间间隔，分别保存年、月、周、日、时、分、秒及纳秒，与ISODuration可直接相互转换
+type CarbonInterval ISODuration
+
+// CarbonIntervalFromDuration 从持续时间创建日历时间间隔，自动进位至天
+func CarbonIntervalFromDuration(d time.Duration) CarbonInterval {
+	return CarbonInterval{Nanoseconds: int(d)}.Cascade()
+}
+
+// ParseCarbonInterval 从ISO8601持续时间字符串创建日历时间间隔，如P1Y2M10DT2H30M
+func ParseCarbonInterval(value string) (CarbonInterval, error) {
+	d, err := ParseISODuration(value)
+	return CarbonInterval(d), err
+}
+
+// DiffAsCarbonInterval 相差的日历时间间隔
+func (c Carbon) DiffAsCarbonInterval(end Carbon) CarbonInterval {
+	return CarbonInterval(c.DiffAsISODuration(end))
+}
+
+// AddCarbonInterval 增加日历时间间隔，年、月与NextMonths一致不做月份溢出
+func (c Carbon) AddCarbonInterval(i CarbonInterval) Carbon {
+	if c.Error != nil {
+		return c
+	}
+	return c.addISODuration(ISODuration(i))
+}
+
+// SubCarbonInterval 减少日历时间间隔
+func (c Carbon) SubCarbonInterval(i CarbonInterval) Carbon {
+	return c.AddCarbonInterval(i.Negate())
+}
+
+// AddTo 将时间间隔加到指定时间上
+func (i CarbonInterval) AddTo(c Carbon) Carbon {
+	return c.AddCarbonInterval(i)
+}
+
+// SubFrom 从指定时间上减去时间间隔
+func (i CarbonInterval) SubFrom(c Carbon) Carbon {
+	return c.SubCarbonInterval(i)
+}
+
+// Add 与另一个时间间隔相加，各部分分别相加
+func (i CarbonInterval) Add(o CarbonInterval) CarbonInterval {
+	return CarbonInterval{i.Years + o.Years, i.Months + o.Months, i.Weeks + o.Weeks, i.Days + o.Days, i.Hours + o.Hours, i.Minutes + o.Minutes, i.Seconds + o.Seconds, i.Nanoseconds + o.Nanoseconds}
+}
+
+// Sub 与另一个时间间隔相减，各部分分别相减
+func (i CarbonInterval) Sub(o CarbonInterval) CarbonInterval {
+	return i.Add(o.Negate())
+}
+
+// Multiply 各部分分别乘以n
+func (i CarbonInterval) Multiply(n int) CarbonInterval {
+	return CarbonInterval{i.Years * n, i.Months * n, i.Weeks * n, i.Days * n, i.Hours * n, i.Minutes * n, i.Seconds * n, i.Nanoseconds * n}
+}
+
+// Negate 取反
+func (i CarbonInterval) Negate() CarbonInterval {
+	return CarbonInterval(ISODuration(i).Negate())
+}
+
+// IsZero 是否是零值
+func (i CarbonInterval) IsZero() bool {
+	return i == CarbonInterval{}
+}
+
+// Cascade 规范化各部分，纳秒、秒、分、时、日依次进位，每天按24小时、每周按7天计算，月进位至年
+// 月与日之间不进位，各部分符号一致，如PT90M规范化为PT1H30M，P1M-1D保持不变，逐级进位不会溢出
+func (i CarbonInterval) Cascade() CarbonInterval {
+	calendar := cascade([]int{i.Months, i.Years}, []int{MonthsPerYear})
+	fixed := cascade([]int{i.Nanoseconds, i.Seconds, i.Minutes, i.Hours, i.Days, i.Weeks}, []int{NanosecondsPerSecond, SecondsPerMinute, MinutesPerHour, HoursPerDay, DaysPerWeek})
+	return CarbonInterval{calendar[1], calendar[0], fixed[5], fixed[4], fixed[3], fixed[2], fixed[1], fixed[0]}
+}
+
+// TotalDays 以指定时间为起点获取总天数，月、年的实际天数取决于起点
+func (i CarbonInterval) TotalDays(from Carbon) float64 {
+	return i.totalDuration(from).Hours() / HoursPerDay
+}
+
+// TotalHours 以指定时间为起点获取总小时数
+func (i CarbonInterval) TotalHours(from Carbon) float64 {
+	return i.totalDuration(from).Hours()
+}
+
+// TotalMinutes 以指定时间为起点获取总分钟数
+func (i CarbonInterval) TotalMinutes(from Carbon) float64 {
+	return i.totalDuration(from).Minutes()
+}
+
+// TotalSeconds 以指定时间为起点获取总秒数
+func (i CarbonInterval) TotalSeconds(from Carbon) float64 {
+	return i.totalDuration(from).Seconds()
+}
+
+// ToISODuration 转换为ISO8601持续时间
+func (i CarbonInterval) ToISODuration() ISODuration {
+	return ISODuration(i)
+}
+
+// String 实现Stringer接口，输出ISO8601持续时间字符串
+func (i CarbonInterval) String() string {
+	return ISODuration(i).String()
+}
+
+// ForHumans 输出对人类友好的时间间隔，如1 year 2 months，默认按照全局区域，可指定区域
+// 先规范化再按照统一的符号输出，月、年与其余部分符号相反时无法换算，各部分按照各自的符号输出，如1 month -1 day
+// 不足一秒的部分以小数秒输出
+func (i CarbonInterval) ForHumans(locale ...string) string {
+	c := Carbon{}
+	if len(locale) > 0 {
+		c.locale = locale[0]
+	}
+	i = i.Cascade()
+	prefix := ""
+	calendarSign, fixedSign := intervalSign(i.Years, i.Months), intervalSign(i.Weeks, i.Days, i.Hours, i.Minutes, i.Seconds, i.Nanoseconds)
+	if mixed := calendarSign != 0 && fixedSign == -calendarSign; !mixed && intervalSign(calendarSign, fixedSign) < 0 {
+		prefix, i = "-", i.Negate()
+	}
+
+	units := []struct {
+		key    string
+		number int
+	}{
+		{"year", i.Years},
+		{"month", i.Months},
+		{"week", i.Weeks},
+		{"day", i.Days},
+		{"hour", i.Hours},
+		{"minute", i.Minutes},
+	}
+
+	parts := make([]string, 0, len(units)+1)
+	for _, unit := range units {
+		if unit.number != 0 {
+			parts = append(parts, signPrefix(unit.number)+c.translateNumber(unit.key, abs(int64(unit.number))))
+		}
+	}
+	if i.Nanoseconds != 0 {
+		seconds := strings.TrimRight(fmt.Sprintf("%d.%09d", abs(int64(i.Seconds)), abs(int64(i.Nanoseconds))), "0")
+		parts = append(parts, signPrefix(i.Seconds, i.Nanoseconds)+c.translateDecimal("second", seconds))
+	} else if i.Seconds != 0 {
+		parts = append(parts, signPrefix(i.Seconds)+c.translateNumber("second", abs(int64(i.Seconds))))
+	}
+	if len(parts) == 0 {
+		return c.translateNumber("second", 0)
+	}
+	return prefix + strings.Join(parts, " ")
+}
+
+// signPrefix 获取首个非零值的符号前缀，负数时返回"-"
+func signPrefix(values ...int) string {
+	if intervalSign(values...) < 0 {
+		return "-"
+	}
+	return ""
+}
+
+// cascade 按照进制从低到高逐级进位，再向高位借位使各单位的符号与最高的非零单位一致
+func cascade(values []int, bases []int) []int {
+	for k, base := range bases {
+		values[k+1] += values[k] / base
+		values[k] %= base
+	}
+	sign := 0
+	for k := len(values) - 1; k >= 0 && sign == 0; k-- {
+		sign = intervalSign(values[k])
+	}
+	for k, base := range bases {
+		switch {
+		case sign > 0 && values[k] < 0:
+			values[k], values[k+1] = values[k]+base, values[k+1]-1
+		case sign < 0 && values[k] > 0:
+			values[k], values[k+1] = values[k]-base, values[k+1]+1
+		}
+	}
+	return values
+}
+
+// intervalSign 获取首个非零值的符号，全部为零时返回0
+func intervalSign(values ...int) int {
+	for _, value := range values {
+		switch {
+		case value > 0:
+			return 1
+		case value < 0:
+			return -1
+		}
+	}
+	return 0
+}
+
+// totalDuration 以指定时间为起点获取实际时长
+func (i CarbonInterval) totalDuration(from Carbon) time.Duration {
+	if from.Error != nil {
+		return 0
+	}
+	return i.AddTo(from).Time.Sub(from.Time)
+}
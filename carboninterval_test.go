@@ -0,0 +1,169 @@
+package carbon
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestCarbonInterval_AddTo(t *testing.T) {
+	Tests := []struct {
+		input    string         // 输入值
+		interval CarbonInterval // 输入参数
+		add      string         // 期望增加后的输出值
+		sub      string         // 期望减少后的输出值
+	}{
+		{"2020-01-31 13:14:15", CarbonInterval{Months: 1}, "2020-02-29 13:14:15", "2019-12-31 13:14:15"},
+		{"2020-02-29 13:14:15", CarbonInterval{Years: 1, Days: 1}, "2021-03-01 13:14:15", "2019-02-27 13:14:15"},
+		{"2020-08-05 13:14:15", CarbonInterval{Weeks: 1, Hours: 1, Minutes: 30}, "2020-08-12 14:44:15", "2020-07-29 11:44:15"},
+		{"2020-08-05 13:14:15", CarbonInterval{}, "2020-08-05 13:14:15", "2020-08-05 13:14:15"},
+		{"xxx", CarbonInterval{Days: 1}, "", ""},
+	}
+
+	for _, v := range Tests {
+		add := v.interval.AddTo(Parse(v.input)).ToDateTimeString()
+		sub := v.interval.SubFrom(Parse(v.input)).ToDateTimeString()
+
+		if add != v.add || Parse(v.input).AddCarbonInterval(v.interval).ToDateTimeString() != v.add {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.add, add)
+		}
+
+		if sub != v.sub || Parse(v.input).SubCarbonInterval(v.interval).ToDateTimeString() != v.sub {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.sub, sub)
+		}
+	}
+}
+
+func TestCarbonInterval_Cascade(t *testing.T) {
+	Tests := []struct {
+		input  CarbonInterval // 输入值
+		output string         // 期望输出值
+	}{
+		{CarbonInterval{Minutes: 90}, "PT1H30M"},
+		{CarbonInterval{Months: 14, Hours: 200}, "P1Y2M1W1DT8H"},
+		{CarbonInterval{Seconds: 59, Nanoseconds: 1500000000}, "PT1M0.5S"},
+		{CarbonInterval{Hours: 1, Minutes: -90}, "-PT30M"},
+		{CarbonInterval{Months: 1, Days: -1}, "P1M-1D"},
+		{CarbonIntervalFromDuration(36*time.Hour + time.Second), "P1DT12H1S"},
+		{CarbonInterval{Days: 1, Hours: -1}, "PT23H"},
+		{CarbonInterval{Weeks: -1, Days: 1, Nanoseconds: 1}, "-P5DT23H59M59.999999999S"},
+		{CarbonInterval{Nanoseconds: math.MaxInt64}, "P15250W1DT23H47M16.854775807S"},
+		{CarbonInterval{Weeks: math.MaxInt64 - 1, Days: 7}, fmt.Sprintf("P%dW", math.MaxInt64)},
+		{CarbonInterval{Years: math.MaxInt64/12 + 1, Months: 13}, fmt.Sprintf("P%dY1M", math.MaxInt64/12+2)},
+	}
+
+	for _, v := range Tests {
+		output := v.input.Cascade().String()
+
+		if output != v.output {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.output, output)
+		}
+	}
+}
+
+func TestCarbonInterval_Arithmetic(t *testing.T) {
+	i := CarbonInterval{Months: 1, Days: 2, Hours: 3}
+	o := CarbonInterval{Years: 1, Days: 3}
+	Tests := []struct {
+		input  CarbonInterval // 输入值
+		output string         // 期望输出值
+	}{
+		{i.Add(o), "P1Y1M5DT3H"},
+		{i.Sub(o), "P-1Y1M-1DT3H"},
+		{o.Sub(o), "PT0S"},
+		{i.Multiply(2), "P2M4DT6H"},
+		{i.Negate(), "-P1M2DT3H"},
+	}
+
+	for _, v := range Tests {
+		if v.input.String() != v.output {
+			t.Fatalf("Expected %s, but got %s\n", v.output, v.input.String())
+		}
+	}
+
+	if !o.Sub(o).IsZero() || i.IsZero() || i.ToISODuration().Months != 1 {
+		t.Fatal("Expected the interval to be converted\n")
+	}
+
+	p, err := ParseCarbonInterval("P1M2DT3H")
+	if err != nil || p != i {
+		t.Fatalf("Expected %s, but got %s\n", i, p)
+	}
+
+	if _, err := ParseCarbonInterval("xxx"); err == nil {
+		t.Fatal("Expected error with an invalid duration\n")
+	}
+
+	if Parse("2020-08-05").DiffAsCarbonInterval(Parse("2020-09-06 01:00:00")) != (CarbonInterval{Months: 1, Days: 1, Hours: 1}) {
+		t.Fatal("Expected the difference to be 1 month 1 day 1 hour\n")
+	}
+}
+
+func TestCarbonInterval_TotalDays(t *testing.T) {
+	Tests := []struct {
+		input    string         // 输入值
+		interval CarbonInterval // 输入参数
+		output   float64        // 期望输出值
+	}{
+		{"2020-02-01", CarbonInterval{Months: 1}, 29},
+		{"2021-02-01", CarbonInterval{Months: 1}, 28},
+		{"2020-01-01", CarbonInterval{Years: 1}, 366},
+		{"2020-08-05", CarbonInterval{Days: 1, Hours: 12}, 1.5},
+		{"xxx", CarbonInterval{Days: 1}, 0},
+	}
+
+	for _, v := range Tests {
+		output := v.interval.TotalDays(Parse(v.input))
+
+		if output != v.output {
+			t.Fatalf("Input %s, expected %f, but got %f\n", v.input, v.output, output)
+		}
+	}
+
+	i := CarbonInterval{Days: 1, Hours: 12}
+	if i.TotalHours(Parse("2020-08-05")) != 36 || i.TotalMinutes(Parse("2020-08-05")) != 2160 || i.TotalSeconds(Parse("2020-08-05")) != 129600 {
+		t.Fatal("Expected the total to be 36 hours\n")
+	}
+}
+
+func TestCarbonInterval_ForHumans(t *testing.T) {
+	Tests := []struct {
+		input  CarbonInterval // 输入值
+		locale string         // 输入参数
+		output string         // 期望输出值
+	}{
+		{CarbonInterval{Years: 1, Months: 2}, "en", "1 year 2 months"},
+		{CarbonInterval{Weeks: 1, Days: 3, Hours: 1, Minutes: 2, Seconds: 3}, "en", "1 week 3 days 1 hour 2 minutes 3 seconds"},
+		{CarbonInterval{Months: -1, Days: -2}, "en", "-1 month 2 days"},
+		{CarbonInterval{}, "en", "0 seconds"},
+		{CarbonInterval{Years: 1, Months: 2}, "zh-CN", "1年 2个月"},
+		{CarbonInterval{Nanoseconds: 500000000}, "en", "0.5 seconds"},
+		{CarbonInterval{Seconds: 1, Nanoseconds: 250000000}, "en", "1.25 seconds"},
+		{CarbonInterval{Nanoseconds: -1}, "en", "-0.000000001 seconds"},
+		{CarbonInterval{Minutes: 1, Nanoseconds: 500000000}, "zh-CN", "1分钟 0.5秒"},
+		{CarbonInterval{Hours: 1, Minutes: -30}, "en", "30 minutes"},
+		{CarbonInterval{Days: -1, Hours: 2}, "en", "-22 hours"},
+		{CarbonInterval{Minutes: 90}, "en", "1 hour 30 minutes"},
+		{CarbonInterval{Months: 1, Days: -1}, "en", "1 month -1 day"},
+		{CarbonInterval{Months: 1, Days: -3}, "en", "1 month -3 days"},
+		{CarbonInterval{Years: -1, Hours: 1}, "en", "-1 year 1 hour"},
+		{CarbonInterval{Months: -1, Weeks: 10}, "en", "-1 month 10 weeks"},
+		{CarbonInterval{Months: 1, Seconds: -1, Nanoseconds: -500000000}, "zh-CN", "1个月 -1.5秒"},
+		{CarbonInterval{Years: math.MaxInt64, Days: -1}, "en", fmt.Sprintf("%d years -1 day", math.MaxInt64)},
+	}
+
+	for _, v := range Tests {
+		output := v.input.ForHumans(v.locale)
+
+		if output != v.output {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.output, output)
+		}
+	}
+
+	SetLocale(SimplifiedChinese)
+	defer SetLocale(DefaultLocale)
+	if (CarbonInterval{Days: 3}).ForHumans() != "3天" {
+		t.Fatalf("Expected the global locale to be used, but got %s\n", (CarbonInterval{Days: 3}).ForHumans())
+	}
+}
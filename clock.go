@@ -0,0 +1,103 @@
+package carbon
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock 时钟接口，用于获取当前时间
+type Clock interface {
+	Now() time.Time
+}
+
+// systemClock 系统时钟
+type systemClock struct{}
+
+// Now 获取系统当前时间
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// TestClock 测试时钟，可冻结、设置或推进当前时间，并发安全
+type TestClock struct {
+	mutex sync.RWMutex
+	now   time.Time
+}
+
+// NewTestClock 创建冻结在指定时间的测试时钟
+func NewTestClock(c Carbon) *TestClock {
+	return &TestClock{now: c.Time}
+}
+
+// Now 获取测试时钟当前时间
+func (tc *TestClock) Now() time.Time {
+	tc.mutex.RLock()
+	defer tc.mutex.RUnlock()
+	return tc.now
+}
+
+// Set 设置测试时钟当前时间
+func (tc *TestClock) Set(c Carbon) {
+	tc.mutex.Lock()
+	defer tc.mutex.Unlock()
+	tc.now = c.Time
+}
+
+// Advance 推进测试时钟当前时间，传入负数时回退
+func (tc *TestClock) Advance(d time.Duration) {
+	tc.mutex.Lock()
+	defer tc.mutex.Unlock()
+	tc.now = tc.now.Add(d)
+}
+
+var (
+	// 全局时钟
+	globalClock Clock = systemClock{}
+
+	// 全局时钟读写锁
+	clockMutex sync.RWMutex
+)
+
+// SetClock 设置全局时钟，传入nil时恢复为系统时钟
+func SetClock(clock Clock) {
+	clockMutex.Lock()
+	defer clockMutex.Unlock()
+	if clock == nil {
+		clock = systemClock{}
+	}
+	globalClock = clock
+}
+
+// SetTestNow 将全局当前时间冻结在指定时间
+func SetTestNow(c Carbon) {
+	SetClock(NewTestClock(c))
+}
+
+// ClearTestNow 清除冻结的全局当前时间，恢复为系统时钟
+func ClearTestNow() {
+	SetClock(nil)
+}
+
+// HasTestNow 全局当前时间是否已冻结
+func HasTestNow() bool {
+	clockMutex.RLock()
+	defer clockMutex.RUnlock()
+	_, ok := globalClock.(*TestClock)
+	return ok
+}
+
+// Clock 设置时钟(仅对当前实例有效)，传入nil时使用全局时钟
+func (c Carbon) Clock(clock Clock) Carbon {
+	c.clock = clock
+	return c
+}
+
+// now 获取本地时区的当前时间，优先使用当前实例的时钟
+func (c Carbon) now() time.Time {
+	if c.clock != nil {
+		return c.clock.Now().In(time.Local)
+	}
+	clockMutex.RLock()
+	defer clockMutex.RUnlock()
+	return globalClock.Now().In(time.Local)
+}
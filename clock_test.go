@@ -0,0 +1,103 @@
+package carbon
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCarbon_SetTestNow(t *testing.T) {
+	SetTestNow(Parse("2020-08-05 13:14:15"))
+	defer ClearTestNow()
+
+	if !HasTestNow() {
+		t.Fatal("Expected test now to be set\n")
+	}
+
+	Tests := []struct {
+		output   string // 实际输出值
+		expected string // 期望输出值
+	}{
+		{Now().ToDateTimeString(), "2020-08-05 13:14:15"},
+		{Tomorrow().ToDateTimeString(), "2020-08-06 13:14:15"},
+		{Yesterday().ToDateTimeString(), "2020-08-04 13:14:15"},
+		{CreateFromDate(2021, 1, 1).ToDateTimeString(), "2021-01-01 13:14:15"},
+		{CreateFromTime(1, 2, 3).ToDateTimeString(), "2020-08-05 01:02:03"},
+		{CreateFromLunar(2020, 12, 8, false).ToDateTimeString(), "2021-01-20 13:14:15"},
+		{ParseByDuration("-2h").ToDateTimeString(), "2020-08-05 11:14:15"},
+		{Timezone(Tokyo).Now().ToDateTimeString(), "2020-08-05 14:14:15"},
+		{Timezone(Tokyo).CreateFromTime(1, 2, 3).ToDateTimeString(), "2020-08-05 02:02:03"},
+		{Parse("2020-08-04 13:14:15").DiffForHumans(), "1 day ago"},
+	}
+
+	for i, v := range Tests {
+		if v.output != v.expected {
+			t.Fatalf("Case %d, expected %s, but got %s\n", i, v.expected, v.output)
+		}
+	}
+
+	if !Parse("2020-08-05 13:14:15").IsNow() || !Parse("2020-08-05").IsToday() || !Parse("2020-08-06").IsTomorrow() || !Parse("2020-08-06").IsFuture() {
+		t.Fatal("Expected comparisons to use test now\n")
+	}
+
+	ClearTestNow()
+	if HasTestNow() || Now().ToDateString() == "2020-08-05" {
+		t.Fatal("Expected test now to be cleared\n")
+	}
+}
+
+func TestCarbon_Clock(t *testing.T) {
+	clock := NewTestClock(Parse("2020-08-05 13:14:15"))
+	c := Timezone(PRC).Clock(clock)
+
+	if output := c.Now().ToDateTimeString(); output != "2020-08-05 13:14:15" {
+		t.Fatalf("Expected 2020-08-05 13:14:15, but got %s\n", output)
+	}
+
+	clock.Advance(time.Hour)
+	if output := c.Now().ToDateTimeString(); output != "2020-08-05 14:14:15" {
+		t.Fatalf("Expected 2020-08-05 14:14:15, but got %s\n", output)
+	}
+
+	clock.Set(Parse("2021-01-01"))
+	if output := c.Now().Yesterday().ToDateString(); output != "2020-12-31" {
+		t.Fatalf("Expected 2020-12-31, but got %s\n", output)
+	}
+
+	// 实例时钟在链式调用中传递
+	if !c.Parse("2021-01-01 08:00:00").IsToday() || !c.Timezone(Tokyo).Now().Timezone(PRC).IsNow() {
+		t.Fatal("Expected instance clock to be passed along the chain\n")
+	}
+
+	// 实例时钟不影响全局时钟
+	if Now().ToDateString() == "2021-01-01" {
+		t.Fatal("Expected global clock to be unaffected\n")
+	}
+}
+
+func TestCarbon_ClockConcurrency(t *testing.T) {
+	defer ClearTestNow()
+	clock := NewTestClock(Parse("2020-08-05 13:14:15"))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			SetClock(clock)
+		}()
+		go func() {
+			defer wg.Done()
+			clock.Advance(time.Second)
+		}()
+		go func() {
+			defer wg.Done()
+			Now()
+		}()
+	}
+	wg.Wait()
+
+	if output := Now().ToDateTimeString(); output != "2020-08-05 13:14:25" {
+		t.Fatalf("Expected 2020-08-05 13:14:25, but got %s\n", output)
+	}
+}
@@ -0,0 +1,122 @@
+package carbon
+
+import "time"
+
+// Eq 是否等于
+func (c Carbon) Eq(t Carbon) bool {
+	return c.comparable(t) && c.Time.Equal(t.Time)
+}
+
+// Ne 是否不等于
+func (c Carbon) Ne(t Carbon) bool {
+	return c.comparable(t) && !c.Time.Equal(t.Time)
+}
+
+// Gt 是否大于
+func (c Carbon) Gt(t Carbon) bool {
+	return c.comparable(t) && c.Time.After(t.Time)
+}
+
+// Gte 是否大于等于
+func (c Carbon) Gte(t Carbon) bool {
+	return c.Gt(t) || c.Eq(t)
+}
+
+// Lt 是否小于
+func (c Carbon) Lt(t Carbon) bool {
+	return c.comparable(t) && c.Time.Before(t.Time)
+}
+
+// Lte 是否小于等于
+func (c Carbon) Lte(t Carbon) bool {
+	return c.Lt(t) || c.Eq(t)
+}
+
+// Between 是否在两个时间之间(不包括这两个时间)
+func (c Carbon) Between(start Carbon, end Carbon) bool {
+	return c.Gt(start) && c.Lt(end)
+}
+
+// BetweenIncludedStart 是否在两个时间之间(包括开始时间)
+func (c Carbon) BetweenIncludedStart(start Carbon, end Carbon) bool {
+	return c.Gte(start) && c.Lt(end)
+}
+
+// BetweenIncludedEnd 是否在两个时间之间(包括结束时间)
+func (c Carbon) BetweenIncludedEnd(start Carbon, end Carbon) bool {
+	return c.Gt(start) && c.Lte(end)
+}
+
+// BetweenIncluded 是否在两个时间之间(包括这两个时间)
+func (c Carbon) BetweenIncluded(start Carbon, end Carbon) bool {
+	return c.Gte(start) && c.Lte(end)
+}
+
+// Closest 获取离当前实例最近的时间，距离相等时返回第一个
+func (c Carbon) Closest(a Carbon, b Carbon) Carbon {
+	if c.Error != nil {
+		return c
+	}
+	if a.Error != nil || b.Error != nil {
+		return Min(a, b)
+	}
+	if distance(c, b) < distance(c, a) {
+		return b
+	}
+	return a
+}
+
+// Farthest 获取离当前实例最远的时间，距离相等时返回第一个
+func (c Carbon) Farthest(a Carbon, b Carbon) Carbon {
+	if c.Error != nil {
+		return c
+	}
+	if a.Error != nil || b.Error != nil {
+		return Max(a, b)
+	}
+	if distance(c, b) > distance(c, a) {
+		return b
+	}
+	return a
+}
+
+// Min 获取最早的时间，存在错误时返回第一个错误实例，无参数时返回零值
+func Min(carbons ...Carbon) Carbon {
+	return extremum(carbons, Carbon.Lt)
+}
+
+// Max 获取最晚的时间，存在错误时返回第一个错误实例，无参数时返回零值
+func Max(carbons ...Carbon) Carbon {
+	return extremum(carbons, Carbon.Gt)
+}
+
+// comparable 两个实例是否都没有错误
+func (c Carbon) comparable(t Carbon) bool {
+	return c.Error == nil && t.Error == nil
+}
+
+// distance 获取两个时间的距离
+func distance(c Carbon, t Carbon) time.Duration {
+	d := t.Time.Sub(c.Time)
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+// extremum 按照比较函数获取最值
+func extremum(carbons []Carbon, better func(Carbon, Carbon) bool) Carbon {
+	if len(carbons) == 0 {
+		return Carbon{loc: time.Local}
+	}
+	result := carbons[0]
+	for _, c := range carbons {
+		if c.Error != nil {
+			return c
+		}
+		if better(c, result) {
+			result = c
+		}
+	}
+	return result
+}
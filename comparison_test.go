@@ -0,0 +1,132 @@
+package carbon
+
+import "testing"
+
+var CompareTests = []struct {
+	input1 string // 输入值1
+	input2 string // 输入值2
+	eq     bool   // 期望是否等于
+	gt     bool   // 期望是否大于
+	lt     bool   // 期望是否小于
+}{
+	{"2020-08-05 13:14:15", "2020-08-05 13:14:15", true, false, false},
+	{"2020-08-05 13:14:15", "2020-08-05 13:14:14", false, true, false},
+	{"2020-08-05 13:14:15", "2020-08-06", false, false, true},
+	{"2020-08-05", "2020-08-05 00:00:00", true, false, false},
+	{"0000-00-00", "2020-08-05", false, false, true},
+}
+
+func TestCarbon_Compare(t *testing.T) {
+	for _, v := range CompareTests {
+		c1, c2 := Parse(v.input1), Parse(v.input2)
+
+		if c1.Eq(c2) != v.eq || c1.Ne(c2) == v.eq {
+			t.Fatalf("Input %s and %s, expected eq %t, but got %t\n", v.input1, v.input2, v.eq, c1.Eq(c2))
+		}
+
+		if c1.Gt(c2) != v.gt || c1.Gte(c2) != (v.gt || v.eq) {
+			t.Fatalf("Input %s and %s, expected gt %t, but got %t\n", v.input1, v.input2, v.gt, c1.Gt(c2))
+		}
+
+		if c1.Lt(c2) != v.lt || c1.Lte(c2) != (v.lt || v.eq) {
+			t.Fatalf("Input %s and %s, expected lt %t, but got %t\n", v.input1, v.input2, v.lt, c1.Lt(c2))
+		}
+	}
+
+	c1 := Parse("2020-08-05 13:14:15")
+	c2 := c1.Duration("1ns")
+	if c1.Eq(c2) || !c1.Lt(c2) {
+		t.Fatal("Expected nanosecond precision comparison\n")
+	}
+
+	invalid := Parse("xxx")
+	if invalid.Eq(invalid) || invalid.Ne(c1) || c1.Gt(invalid) || c1.Lte(invalid) {
+		t.Fatal("Expected false when comparing with an invalid instance\n")
+	}
+}
+
+func TestCarbon_Between(t *testing.T) {
+	start, end := Parse("2020-08-05 13:14:15"), Parse("2020-08-06 13:14:15")
+	Tests := []struct {
+		input         string // 输入值
+		between       bool   // 期望Between输出值
+		includedStart bool   // 期望BetweenIncludedStart输出值
+		includedEnd   bool   // 期望BetweenIncludedEnd输出值
+		included      bool   // 期望BetweenIncluded输出值
+	}{
+		{"2020-08-05 13:14:14", false, false, false, false},
+		{"2020-08-05 13:14:15", false, true, false, true},
+		{"2020-08-05 23:59:59", true, true, true, true},
+		{"2020-08-06 13:14:15", false, false, true, true},
+		{"2020-08-06 13:14:16", false, false, false, false},
+		{"xxx", false, false, false, false},
+	}
+
+	for _, v := range Tests {
+		c := Parse(v.input)
+
+		if c.Between(start, end) != v.between {
+			t.Fatalf("Input %s, expected Between %t\n", v.input, v.between)
+		}
+
+		if c.BetweenIncludedStart(start, end) != v.includedStart {
+			t.Fatalf("Input %s, expected BetweenIncludedStart %t\n", v.input, v.includedStart)
+		}
+
+		if c.BetweenIncludedEnd(start, end) != v.includedEnd {
+			t.Fatalf("Input %s, expected BetweenIncludedEnd %t\n", v.input, v.includedEnd)
+		}
+
+		if c.BetweenIncluded(start, end) != v.included {
+			t.Fatalf("Input %s, expected BetweenIncluded %t\n", v.input, v.included)
+		}
+	}
+}
+
+func TestCarbon_ClosestAndFarthest(t *testing.T) {
+	Tests := []struct {
+		input    string // 输入值
+		a        string // 输入参数a
+		b        string // 输入参数b
+		closest  string // 期望Closest输出值
+		farthest string // 期望Farthest输出值
+	}{
+		{"2020-08-05 13:14:15", "2020-08-04 13:14:15", "2020-08-07 13:14:15", "2020-08-04 13:14:15", "2020-08-07 13:14:15"},
+		{"2020-08-05 13:14:15", "2020-08-08 13:14:15", "2020-08-05 12:14:15", "2020-08-05 12:14:15", "2020-08-08 13:14:15"},
+		{"2020-08-05 13:14:15", "2020-08-04 13:14:15", "2020-08-06 13:14:15", "2020-08-04 13:14:15", "2020-08-04 13:14:15"},
+		{"xxx", "2020-08-04 13:14:15", "2020-08-06 13:14:15", "", ""},
+		{"2020-08-05 13:14:15", "2020-08-04 13:14:15", "xxx", "", ""},
+	}
+
+	for _, v := range Tests {
+		c, a, b := Parse(v.input), Parse(v.a), Parse(v.b)
+
+		if output := c.Closest(a, b).ToDateTimeString(); output != v.closest {
+			t.Fatalf("Input %s, expected closest %s, but got %s\n", v.input, v.closest, output)
+		}
+
+		if output := c.Farthest(a, b).ToDateTimeString(); output != v.farthest {
+			t.Fatalf("Input %s, expected farthest %s, but got %s\n", v.input, v.farthest, output)
+		}
+	}
+}
+
+func TestCarbon_MinAndMax(t *testing.T) {
+	c1, c2, c3 := Parse("2020-08-05 13:14:15"), Parse("2020-08-04 13:14:15"), Parse("2020-08-06 13:14:15")
+
+	if output := Min(c1, c2, c3).ToDateTimeString(); output != "2020-08-04 13:14:15" {
+		t.Fatalf("Expected min 2020-08-04 13:14:15, but got %s\n", output)
+	}
+
+	if output := Max(c1, c2, c3).ToDateTimeString(); output != "2020-08-06 13:14:15" {
+		t.Fatalf("Expected max 2020-08-06 13:14:15, but got %s\n", output)
+	}
+
+	if !Min().IsZero() || !Max().IsZero() {
+		t.Fatal("Expected zero value without arguments\n")
+	}
+
+	if Min(c1, Parse("xxx")).Error == nil || Max(Parse("xxx"), c1).Error == nil {
+		t.Fatal("Expected error with an invalid instance\n")
+	}
+}
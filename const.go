@@ -78,27 +78,29 @@ const (
 
 // 数字常量
 const (
-	YearsPerMillennium         = 1000    // 每千年1000年
-	YearsPerCentury            = 100     // 每世纪100年
-	YearsPerDecade             = 10      // 每十年10年
-	QuartersPerYear            = 4       // 每年4季度
-	MonthsPerYear              = 12      // 每年12月
-	MonthsPerQuarter           = 3       // 每季度3月
-	WeeksPerYear               = 52      // 每年52周
-	WeeksPerMonth              = 4       // 每月4周
-	DaysPerLeapYear            = 366     // 每闰年366天
-	DaysPerNormalYear          = 365     // 每常规年365天
-	DaysPerWeek                = 7       // 每周7天
-	HoursPerWeek               = 168     // 每周168小时
-	HoursPerDay                = 24      // 每天24小时
-	MinutesPerDay              = 1440    // 每天1440分钟
-	MinutesPerHour             = 60      // 每小时60分钟
-	SecondsPerWeek             = 691200  // 每周691200秒
-	SecondsPerDay              = 86400   // 每天86400秒
-	SecondsPerMinute           = 60      // 每分钟60秒
-	MillisecondsPerSecond      = 1000    // 每秒1000毫秒
-	MicrosecondsPerMillisecond = 1000    // 每毫秒1000微秒
-	MicrosecondsPerSecond      = 1000000 // 每秒1000000微秒
+	YearsPerMillennium         = 1000       // 每千年1000年
+	YearsPerCentury            = 100        // 每世纪100年
+	YearsPerDecade             = 10         // 每十年10年
+	QuartersPerYear            = 4          // 每年4季度
+	MonthsPerYear              = 12         // 每年12月
+	MonthsPerQuarter           = 3          // 每季度3月
+	WeeksPerYear               = 52         // 每年52周
+	WeeksPerMonth              = 4          // 每月4周
+	DaysPerLeapYear            = 366        // 每闰年366天
+	DaysPerNormalYear          = 365        // 每常规年365天
+	DaysPerWeek                = 7          // 每周7天
+	HoursPerWeek               = 168        // 每周168小时
+	HoursPerDay                = 24         // 每天24小时
+	MinutesPerDay              = 1440       // 每天1440分钟
+	MinutesPerHour             = 60         // 每小时60分钟
+	SecondsPerWeek             = 691200     // 每周691200秒
+	SecondsPerDay              = 86400      // 每天86400秒
+	SecondsPerHour             = 3600       // 每小时3600秒
+	SecondsPerMinute           = 60         // 每分钟60秒
+	MillisecondsPerSecond      = 1000       // 每秒1000毫秒
+	MicrosecondsPerMillisecond = 1000       // 每毫秒1000微秒
+	MicrosecondsPerSecond      = 1000000    // 每秒1000000微秒
+	NanosecondsPerSecond       = 1000000000 // 每秒1000000000纳秒
 )
 
 // 时间格式化常量
@@ -114,6 +116,7 @@ const (
 	RssFormat           = time.RFC1123Z
 	RFC2822Format       = time.RFC1123Z
 	RFC3339Format       = time.RFC3339
+	RFC3339MilliFormat  = "2006-01-02T15:04:05.000Z07:00"
 	KitchenFormat       = time.Kitchen
 	CookieFormat        = "Monday, 02-Jan-2006 15:04:05 MST"
 	RFC1036Format       = "Mon, 02 Jan 06 15:04:05 -0700"
@@ -0,0 +1,109 @@
+package carbon
+
+var (
+	// 星座起始日期(月、日)，依次为白羊座至双鱼座，与语言包中的constellations顺序一致
+	constellationStarts = [12][2]int{
+		{3, 21}, {4, 20}, {5, 21}, {6, 22}, {7, 23}, {8, 23},
+		{9, 23}, {10, 24}, {11, 23}, {12, 22}, {1, 20}, {2, 19},
+	}
+)
+
+const (
+	aries       = iota // 白羊座
+	taurus             // 金牛座
+	gemini             // 双子座
+	cancer             // 巨蟹座
+	leo                // 狮子座
+	virgo              // 处女座
+	libra              // 天秤座
+	scorpio            // 天蝎座
+	sagittarius        // 射手座
+	capricorn          // 摩羯座
+	aquarius           // 水瓶座
+	pisces             // 双鱼座
+)
+
+// Constellation 获取星座(按照当前区域)
+func (c Carbon) Constellation() string {
+	if c.Time.IsZero() {
+		return ""
+	}
+	return c.translateItem("constellations", c.constellation())
+}
+
+// constellation 获取星座序号，白羊座为0，零值时返回-1
+func (c Carbon) constellation() int {
+	if c.Time.IsZero() {
+		return -1
+	}
+	_, month, day := c.Time.Date()
+	date := int(month)*100 + day
+	// 1月1日至1月19日不晚于任何起始日期，属于摩羯座
+	index, latest := capricorn, 0
+	for i, start := range constellationStarts {
+		if s := start[0]*100 + start[1]; s <= date && s > latest {
+			index, latest = i, s
+		}
+	}
+	return index
+}
+
+// IsAries 是否是白羊座
+func (c Carbon) IsAries() bool {
+	return c.constellation() == aries
+}
+
+// IsTaurus 是否是金牛座
+func (c Carbon) IsTaurus() bool {
+	return c.constellation() == taurus
+}
+
+// IsGemini 是否是双子座
+func (c Carbon) IsGemini() bool {
+	return c.constellation() == gemini
+}
+
+// IsCancer 是否是巨蟹座
+func (c Carbon) IsCancer() bool {
+	return c.constellation() == cancer
+}
+
+// IsLeo 是否是狮子座
+func (c Carbon) IsLeo() bool {
+	return c.constellation() == leo
+}
+
+// IsVirgo 是否是处女座
+func (c Carbon) IsVirgo() bool {
+	return c.constellation() == virgo
+}
+
+// IsLibra 是否是天秤座
+func (c Carbon) IsLibra() bool {
+	return c.constellation() == libra
+}
+
+// IsScorpio 是否是天蝎座
+func (c Carbon) IsScorpio() bool {
+	return c.constellation() == scorpio
+}
+
+// IsSagittarius 是否是射手座
+func (c Carbon) IsSagittarius() bool {
+	return c.constellation() == sagittarius
+}
+
+// IsCapricorn 是否是摩羯座
+func (c Carbon) IsCapricorn() bool {
+	return c.constellation() == capricorn
+}
+
+// IsAquarius 是否是水瓶座
+func (c Carbon) IsAquarius() bool {
+	return c.constellation() == aquarius
+}
+
+// IsPisces 是否是双鱼座
+func (c Carbon) IsPisces() bool {
+	return c.constellation() == pisces
+}
@@ -0,0 +1,72 @@
+package carbon
+
+import (
+	"testing"
+)
+
+func TestCarbon_Constellation(t *testing.T) {
+	Tests := []struct {
+		input  string // 输入值
+		locale string // 输入参数
+		output string // 期望输出值
+	}{
+		{"0000-00-00", "en", ""},
+		{"2020-01-01", "en", "Capricorn"},
+		{"2020-01-19", "en", "Capricorn"},
+		{"2020-01-20", "en", "Aquarius"},
+		{"2020-02-19", "en", "Pisces"},
+		{"2020-03-20 23:59:59", "en", "Pisces"},
+		{"2020-03-21", "en", "Aries"},
+		{"2020-04-20", "en", "Taurus"},
+		{"2020-05-21", "en", "Gemini"},
+		{"2020-06-22", "en", "Cancer"},
+		{"2020-08-05 13:14:15", "en", "Leo"},
+		{"2020-08-23", "en", "Virgo"},
+		{"2020-10-23", "en", "Libra"},
+		{"2020-10-24", "en", "Scorpio"},
+		{"2020-11-23", "en", "Sagittarius"},
+		{"2020-12-22", "en", "Capricorn"},
+		{"2020-08-05", "zh-CN", "狮子座"},
+		{"2020-08-05", "xx", ""},
+	}
+
+	for _, v := range Tests {
+		output := Parse(v.input).Locale(v.locale).Constellation()
+
+		if output != v.output {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.output, output)
+		}
+	}
+}
+
+func TestCarbon_IsConstellation(t *testing.T) {
+	Tests := []struct {
+		input  string // 输入值
+		output []bool // 期望输出值，依次为白羊座至双鱼座
+	}{
+		{"0000-00-00", []bool{false, false, false, false, false, false, false, false, false, false, false, false}},
+		{"2020-03-21", []bool{true, false, false, false, false, false, false, false, false, false, false, false}},
+		{"2020-05-20", []bool{false, true, false, false, false, false, false, false, false, false, false, false}},
+		{"2020-06-21", []bool{false, false, true, false, false, false, false, false, false, false, false, false}},
+		{"2020-07-22", []bool{false, false, false, true, false, false, false, false, false, false, false, false}},
+		{"2020-08-22", []bool{false, false, false, false, true, false, false, false, false, false, false, false}},
+		{"2020-09-22", []bool{false, false, false, false, false, true, false, false, false, false, false, false}},
+		{"2020-09-23", []bool{false, false, false, false, false, false, true, false, false, false, false, false}},
+		{"2020-11-22", []bool{false, false, false, false, false, false, false, true, false, false, false, false}},
+		{"2020-12-21", []bool{false, false, false, false, false, false, false, false, true, false, false, false}},
+		{"2021-01-19", []bool{false, false, false, false, false, false, false, false, false, true, false, false}},
+		{"2021-02-18", []bool{false, false, false, false, false, false, false, false, false, false, true, false}},
+		{"2020-02-29", []bool{false, false, false, false, false, false, false, false, false, false, false, true}},
+	}
+
+	for _, v := range Tests {
+		c := Parse(v.input)
+		output := []bool{c.IsAries(), c.IsTaurus(), c.IsGemini(), c.IsCancer(), c.IsLeo(), c.IsVirgo(), c.IsLibra(), c.IsScorpio(), c.IsSagittarius(), c.IsCapricorn(), c.IsAquarius(), c.IsPisces()}
+
+		for i := range output {
+			if output[i] != v.output[i] {
+				t.Fatalf("Input %s, expected %v, but got %v\n", v.input, v.output, output)
+			}
+		}
+	}
+}
@@ -3,9 +3,13 @@ package carbon
 import (
 	"database/sql/driver"
 	"fmt"
+	"strconv"
 	"time"
 )
 
+// 秒级时间戳上限，超过时按毫秒级时间戳处理
+const maxSecondTimestamp = 1e11
+
 type ToDateTimeString struct {
 	Carbon
 }
@@ -22,13 +26,63 @@ type ToTimestamp struct {
 	Carbon
 }
 
+type ToTimestampMilli struct {
+	Carbon
+}
+
+type ToTimestampMicro struct {
+	Carbon
+}
+
+type ToRFC3339String struct {
+	Carbon
+}
+
+type ToRFC3339Milli struct {
+	Carbon
+}
+
+// Scan 实现sql.Scanner接口，支持time.Time、[]byte、string、int64(秒级或毫秒级时间戳)和nil
+// 不含时区的时间字符串按照当前实例的时区解析，未设置时使用本地时区
 func (c *Carbon) Scan(v interface{}) error {
-	value, ok := v.(time.Time)
-	if ok {
-		*c = Carbon{Time: value}
+	loc := c.location()
+	switch value := v.(type) {
+	case nil:
+		c.setTime(time.Time{}, loc)
+		return nil
+	case time.Time:
+		c.setTime(value.In(loc), loc)
+		return nil
+	case []byte:
+		return c.scanString(string(value))
+	case string:
+		return c.scanString(value)
+	case int64:
+		t := time.Unix(value, 0)
+		// 超过秒级时间戳范围(公元5138年)时按毫秒级时间戳处理
+		if abs(value) >= maxSecondTimestamp {
+			t = CreateFromTimestampMilli(value).Time
+		}
+		c.setTime(t.In(loc), loc)
 		return nil
 	}
-	return fmt.Errorf("can not convert %v to timestamp", v)
+	return invalidScanError(v)
+}
+
+// scanString 按照Parse的规则解析数据库中的时间字符串
+func (c *Carbon) scanString(value string) error {
+	loc := c.location()
+	if isZeroValue(value) {
+		c.setTime(time.Time{}, loc)
+		return nil
+	}
+	layout := guessLayout(value)
+	t, err := time.ParseInLocation(layout, value, loc)
+	if err != nil {
+		return invalidValueError(value, layout)
+	}
+	c.setTime(t.In(loc), loc)
+	return nil
 }
 
 func (c Carbon) Value() (driver.Value, error) {
@@ -59,3 +113,144 @@ func (c ToTimeString) MarshalJSON() ([]byte, error) {
 func (c ToTimestamp) MarshalJSON() ([]byte, error) {
 	return []byte(fmt.Sprintf(`%d`, c.ToTimestamp())), nil
 }
+
+func (c ToTimestampMilli) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf(`%d`, c.ToTimestampWithMillisecond())), nil
+}
+
+func (c ToTimestampMicro) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf(`%d`, c.ToTimestampWithMicrosecond())), nil
+}
+
+func (c ToRFC3339String) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf(`"%s"`, c.ToRFC3339String())), nil
+}
+
+func (c ToRFC3339Milli) MarshalJSON() ([]byte, error) {
+	if c.Time.IsZero() {
+		return []byte(`""`), nil
+	}
+	return []byte(fmt.Sprintf(`"%s"`, c.Time.Format(RFC3339MilliFormat))), nil
+}
+
+// MarshalJSONByFormat 按照指定格式化符号输出JSON字符串，用于自定义JSON输出格式
+func (c Carbon) MarshalJSONByFormat(format string) ([]byte, error) {
+	return []byte(strconv.Quote(c.ToFormatString(format))), nil
+}
+
+func (c *Carbon) UnmarshalJSON(data []byte) error {
+	return c.unmarshalByLayouts(data, DateTimeFormat, RFC3339Format, DateFormat)
+}
+
+func (c *ToDateTimeString) UnmarshalJSON(data []byte) error {
+	return c.unmarshalByLayouts(data, DateTimeFormat, RFC3339Format)
+}
+
+func (c *ToDateString) UnmarshalJSON(data []byte) error {
+	return c.unmarshalByLayouts(data, DateFormat)
+}
+
+func (c *ToTimeString) UnmarshalJSON(data []byte) error {
+	return c.unmarshalByLayouts(data, TimeFormat)
+}
+
+func (c *ToTimestamp) UnmarshalJSON(data []byte) error {
+	return c.unmarshalByTimestamp(data, 1)
+}
+
+func (c *ToTimestampMilli) UnmarshalJSON(data []byte) error {
+	return c.unmarshalByTimestamp(data, MillisecondsPerSecond)
+}
+
+func (c *ToTimestampMicro) UnmarshalJSON(data []byte) error {
+	return c.unmarshalByTimestamp(data, MicrosecondsPerSecond)
+}
+
+func (c *ToRFC3339String) UnmarshalJSON(data []byte) error {
+	return c.unmarshalByLayouts(data, RFC3339Format)
+}
+
+func (c *ToRFC3339Milli) UnmarshalJSON(data []byte) error {
+	return c.unmarshalByLayouts(data, RFC3339MilliFormat, RFC3339Format)
+}
+
+// UnmarshalJSONByFormat 按照指定格式化符号解析JSON字符串，用于自定义JSON解析格式，null和空字符串解析为零值
+func (c *Carbon) UnmarshalJSONByFormat(data []byte, format string) error {
+	value, isNull, err := unquoteJSON(data)
+	if err != nil {
+		return err
+	}
+	if isNull || value == "" {
+		c.setTime(time.Time{}, c.location())
+		return nil
+	}
+	t, err := c.parseByFormat(value, format)
+	if err != nil {
+		return err
+	}
+	c.setTime(t.In(c.location()), c.location())
+	return nil
+}
+
+// unmarshalByLayouts 按照布局模板依次尝试解析JSON字符串，null和空字符串解析为零值
+// 不含时区的时间按照当前实例的时区解析，未设置时使用本地时区
+func (c *Carbon) unmarshalByLayouts(data []byte, layouts ...string) error {
+	value, isNull, err := unquoteJSON(data)
+	if err != nil {
+		return err
+	}
+	loc := c.location()
+	if isNull || value == "" {
+		c.setTime(time.Time{}, loc)
+		return nil
+	}
+	for _, layout := range layouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			// 含时差的时间转换为当前实例的时区，保证输出的时间与时间戳一致
+			c.setTime(t.In(loc), loc)
+			return nil
+		}
+	}
+	return invalidValueError(value, layouts[0])
+}
+
+// unmarshalByTimestamp 按照精度(每秒的单位数)解析JSON时间戳，同时接受数字和数字字符串，null和空字符串解析为零值
+func (c *Carbon) unmarshalByTimestamp(data []byte, precision int64) error {
+	value, isNull, err := unquoteJSON(data)
+	if err != nil {
+		return err
+	}
+	loc := c.location()
+	if isNull || value == "" {
+		c.setTime(time.Time{}, loc)
+		return nil
+	}
+	timestamp, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return invalidTimestampError(value)
+	}
+	// 零值实例输出的时间戳
+	if timestamp == (time.Time{}).Unix()*precision {
+		c.setTime(time.Time{}, loc)
+		return nil
+	}
+	t := time.Unix(timestamp/precision, timestamp%precision*(int64(time.Second)/precision))
+	c.setTime(t.In(loc), loc)
+	return nil
+}
+
+// unquoteJSON 去除JSON字符串的引号，并判断是否是null
+func unquoteJSON(data []byte) (string, bool, error) {
+	value := string(data)
+	if value == "null" {
+		return "", true, nil
+	}
+	if len(value) >= 2 && value[0] == '"' {
+		unquoted, err := strconv.Unquote(value)
+		if err != nil {
+			return "", false, err
+		}
+		return unquoted, false, nil
+	}
+	return value, false, nil
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 )
 
 var user = struct {
@@ -28,3 +29,352 @@ func TestCarbon_MarshalJSON(t *testing.T) {
 	data, _ := json.Marshal(&user)
 	fmt.Print("Model output by json:\n", string(data))
 }
+
+func TestCarbon_UnmarshalJSON(t *testing.T) {
+	type model struct {
+		Birthday    Carbon           `json:"birthday"`
+		CreatedAt   ToDateTimeString `json:"created_at"`
+		DeletedAt   ToTimestamp      `json:"deleted_at"`
+		GraduatedAt ToDateString     `json:"graduated_at"`
+		UpdatedAt   ToTimeString     `json:"updated_at"`
+	}
+
+	Tests := []struct {
+		input       string // 输入值
+		birthday    string // 期望Birthday输出值
+		createdAt   string // 期望CreatedAt输出值
+		deletedAt   int64  // 期望DeletedAt输出值
+		graduatedAt string // 期望GraduatedAt输出值
+		updatedAt   string // 期望UpdatedAt输出值
+	}{
+		{`{"birthday":"2002-08-05 13:14:15","created_at":"2020-08-05 13:14:15","deleted_at":1596604455,"graduated_at":"2012-09-09","updated_at":"13:14:15"}`, "2002-08-05 13:14:15", "2020-08-05 13:14:15", 1596604455, "2012-09-09", "13:14:15"},
+		{`{"birthday":"2002-08-05","created_at":"2020-08-05T13:14:15+08:00","deleted_at":"1596604455"}`, "2002-08-05 00:00:00", "2020-08-05 13:14:15", 1596604455, "", ""},
+		{`{"birthday":null,"created_at":"","deleted_at":null,"graduated_at":null,"updated_at":""}`, "", "", -62135596800, "", ""},
+		{`{"deleted_at":-62135596800}`, "", "", -62135596800, "", ""},
+	}
+
+	for _, v := range Tests {
+		var m model
+		if err := json.Unmarshal([]byte(v.input), &m); err != nil {
+			t.Fatalf("Input %s, unexpected error %v\n", v.input, err)
+		}
+
+		if m.Birthday.ToDateTimeString() != v.birthday || m.CreatedAt.ToDateTimeString() != v.createdAt || m.DeletedAt.ToTimestamp() != v.deletedAt || m.GraduatedAt.ToDateString() != v.graduatedAt || m.UpdatedAt.ToTimeString() != v.updatedAt {
+			t.Fatalf("Input %s, expected %s|%s|%d|%s|%s, but got %s|%s|%d|%s|%s\n", v.input, v.birthday, v.createdAt, v.deletedAt, v.graduatedAt, v.updatedAt, m.Birthday.ToDateTimeString(), m.CreatedAt.ToDateTimeString(), m.DeletedAt.ToTimestamp(), m.GraduatedAt.ToDateString(), m.UpdatedAt.ToTimeString())
+		}
+	}
+
+	errorTests := []string{
+		`{"birthday":"xxx"}`,
+		`{"created_at":"2020-08-05"}`,
+		`{"graduated_at":"2020-08-05 13:14:15"}`,
+		`{"updated_at":"13:14"}`,
+		`{"deleted_at":"xxx"}`,
+		`{"deleted_at":1.5}`,
+	}
+
+	for _, input := range errorTests {
+		var m model
+		if err := json.Unmarshal([]byte(input), &m); err == nil {
+			t.Fatalf("Input %s, expected error, but got nil\n", input)
+		}
+	}
+}
+
+func TestCarbon_UnmarshalJSONWithTimezone(t *testing.T) {
+	c := Timezone(Tokyo).Now()
+	if err := json.Unmarshal([]byte(`"2020-08-05 13:14:15"`), &c); err != nil {
+		t.Fatalf("Unexpected error %v\n", err)
+	}
+
+	if output := c.ToFormatString("Y-m-d H:i:s e"); output != "2020-08-05 13:14:15 Asia/Tokyo" {
+		t.Fatalf("Expected 2020-08-05 13:14:15 Asia/Tokyo, but got %s\n", output)
+	}
+}
+
+func TestCarbon_UnmarshalJSONWithOffset(t *testing.T) {
+	Tests := []struct {
+		input     string // 输入值
+		timestamp int64  // 期望时间戳
+	}{
+		{`"2020-08-05T13:14:15+09:00"`, 1596600855},
+		{`"2020-08-05T05:14:15Z"`, 1596604455},
+		{`"2020-08-05T13:14:15+08:00"`, 1596604455},
+	}
+
+	for _, v := range Tests {
+		c := Timezone(PRC)
+		if err := json.Unmarshal([]byte(v.input), &c); err != nil {
+			t.Fatalf("Input %s, unexpected error %v\n", v.input, err)
+		}
+
+		if c.ToTimestamp() != v.timestamp || c.ToDateTimeString() != c.ToFormatString("Y-m-d H:i:s") {
+			t.Fatalf("Input %s, expected %d, but got %d %s\n", v.input, v.timestamp, c.ToTimestamp(), c.ToDateTimeString())
+		}
+
+		// 输出后再次解析，时间戳保持不变
+		data, _ := json.Marshal(c)
+		output := Timezone(PRC)
+		if err := json.Unmarshal(data, &output); err != nil || output.ToTimestamp() != v.timestamp {
+			t.Fatalf("Input %s, expected %d after round trip, but got %d\n", v.input, v.timestamp, output.ToTimestamp())
+		}
+	}
+
+	var rss toRssString
+	rss.Carbon = Timezone(PRC)
+	if err := json.Unmarshal([]byte(`"Wed, 05 Aug 2020 14:14:15 +0900"`), &rss); err != nil || rss.ToDateTimeString() != "2020-08-05 13:14:15" {
+		t.Fatalf("Expected 2020-08-05 13:14:15, but got %s\n", rss.ToDateTimeString())
+	}
+}
+
+func TestCarbon_JSONRoundTrip(t *testing.T) {
+	data, _ := json.Marshal(&user)
+	output := user
+	output.Birthday, output.DeletedAt, output.GraduatedAt, output.UpdatedAt = Carbon{}, ToTimestamp{}, ToDateString{}, ToTimeString{}
+	if err := json.Unmarshal(data, &output); err != nil {
+		t.Fatalf("Unexpected error %v\n", err)
+	}
+
+	if output.Birthday.ToDateTimeString() != user.Birthday.ToDateTimeString() || output.DeletedAt.ToTimestamp() != user.DeletedAt.ToTimestamp() || output.GraduatedAt.ToDateString() != user.GraduatedAt.ToDateString() || output.UpdatedAt.ToTimeString() != user.UpdatedAt.ToTimeString() {
+		t.Fatalf("Expected %s, but got %+v\n", data, output)
+	}
+}
+
+type toRssString struct {
+	Carbon
+}
+
+func (c toRssString) MarshalJSON() ([]byte, error) {
+	return c.MarshalJSONByFormat("D, d M Y H:i:s O")
+}
+
+func (c *toRssString) UnmarshalJSON(data []byte) error {
+	return c.UnmarshalJSONByFormat(data, "D, d M Y H:i:s O")
+}
+
+func TestCarbon_JSONLayouts(t *testing.T) {
+	type model struct {
+		Milli        ToTimestampMilli `json:"milli"`
+		Micro        ToTimestampMicro `json:"micro"`
+		RFC3339      ToRFC3339String  `json:"rfc3339"`
+		RFC3339Milli ToRFC3339Milli   `json:"rfc3339_milli"`
+		Rss          toRssString      `json:"rss"`
+	}
+
+	c := Parse("2020-08-05 13:14:15").Duration("123.456789ms")
+	input := model{ToTimestampMilli{c}, ToTimestampMicro{c}, ToRFC3339String{c}, ToRFC3339Milli{c}, toRssString{c}}
+	expected := `{"milli":1596604455123,"micro":1596604455123456,"rfc3339":"2020-08-05T13:14:15+08:00","rfc3339_milli":"2020-08-05T13:14:15.123+08:00","rss":"Wed, 05 Aug 2020 13:14:15 +0800"}`
+
+	data, _ := json.Marshal(&input)
+	if string(data) != expected {
+		t.Fatalf("Expected %s, but got %s\n", expected, data)
+	}
+
+	var output model
+	if err := json.Unmarshal(data, &output); err != nil {
+		t.Fatalf("Unexpected error %v\n", err)
+	}
+
+	if output.Milli.ToFormatString("Y-m-d H:i:s.u") != "2020-08-05 13:14:15.123000" || output.Micro.ToFormatString("Y-m-d H:i:s.u") != "2020-08-05 13:14:15.123456" {
+		t.Fatalf("Expected millisecond and microsecond precision, but got %s and %s\n", output.Milli.ToFormatString("Y-m-d H:i:s.u"), output.Micro.ToFormatString("Y-m-d H:i:s.u"))
+	}
+
+	if output.RFC3339.ToDateTimeString() != "2020-08-05 13:14:15" || output.RFC3339Milli.ToFormatString("Y-m-d H:i:s.v") != "2020-08-05 13:14:15.123" || output.Rss.ToDateTimeString() != "2020-08-05 13:14:15" {
+		t.Fatalf("Unexpected output %+v\n", output)
+	}
+
+	var zero model
+	data, _ = json.Marshal(&zero)
+	output = model{ToTimestampMilli{c}, ToTimestampMicro{c}, ToRFC3339String{c}, ToRFC3339Milli{c}, toRssString{c}}
+	if err := json.Unmarshal(data, &output); err != nil {
+		t.Fatalf("Unexpected error %v\n", err)
+	}
+
+	if !output.Milli.IsZero() || !output.Micro.IsZero() || !output.RFC3339.IsZero() || !output.RFC3339Milli.IsZero() || !output.Rss.IsZero() {
+		t.Fatalf("Expected zero value, but got %+v\n", output)
+	}
+
+	if err := json.Unmarshal([]byte(`{"rss":"2020-08-05"}`), &output); err == nil {
+		t.Fatal("Expected error, but got nil\n")
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	type period struct {
+		Start Carbon `json:"start" carbon:"format=Y年m月d日"`
+	}
+	type Base struct {
+		DeletedAt Carbon `json:"deleted_at" carbon:"layout=2006-01-02"`
+	}
+	type model struct {
+		Base
+		Birthday  Carbon  `json:"birthday" carbon:"layout=2006-01-02"`
+		CreatedAt Carbon  `json:"created_at" carbon:"format=Y-m-d\\TH:i:s.vO"`
+		LoginAt   *Carbon `json:"login_at" carbon:"layout=15:04"`
+		LogoutAt  *Carbon `json:"logout_at,omitempty" carbon:"layout=15:04"`
+		UpdatedAt Carbon  `json:"updated_at"`
+		Period    *period `json:"period"`
+		Name      string  `json:"name"`
+	}
+
+	c := Timezone(PRC).CreateFromGoTime(time.Date(2020, 8, 5, 13, 14, 15, 123e6, time.FixedZone("CST", 8*SecondsPerHour)))
+	login := c
+	input := model{Birthday: c, CreatedAt: c, LoginAt: &login, UpdatedAt: c, Period: &period{c}, Name: "carbon"}
+	expected := `{"deleted_at":"","birthday":"2020-08-05","created_at":"2020-08-05T13:14:15.123+0800","login_at":"13:14","updated_at":"2020-08-05 13:14:15","period":{"start":"2020年08月05日"},"name":"carbon"}`
+
+	for _, v := range []interface{}{input, &input} {
+		if data, err := MarshalJSON(v); err != nil || string(data) != expected {
+			t.Fatalf("Expected %s, but got %s, error %v\n", expected, data, err)
+		}
+	}
+
+	// 标签不影响字段的值，派生值及json.Marshal按照默认格式输出
+	if input.Birthday != c || input.Birthday.AddDay() != c.AddDay() {
+		t.Fatal("Expected the field to be unchanged\n")
+	}
+	if data, _ := json.Marshal(input.Birthday.AddDay()); string(data) != `"2020-08-06 13:14:15"` {
+		t.Fatalf("Expected the derived value to use the default format, but got %s\n", data)
+	}
+
+	// 不含carbon标签的结构体与json.Marshal一致
+	if data, _ := MarshalJSON(period{}); string(data) != `{"start":""}` {
+		t.Fatalf("Unexpected output %s\n", data)
+	}
+	if data, _ := MarshalJSON(struct{ Start Carbon }{c}); string(data) != `{"Start":"2020-08-05 13:14:15"}` {
+		t.Fatalf("Unexpected output %s\n", data)
+	}
+	if data, _ := MarshalJSON(c); string(data) != `"2020-08-05 13:14:15"` {
+		t.Fatalf("Unexpected output %s\n", data)
+	}
+
+	var invalidTag struct {
+		Birthday Carbon `carbon:"xxx"`
+	}
+	var invalidField struct {
+		Birthday time.Time `carbon:"layout=2006-01-02"`
+	}
+	for _, v := range []interface{}{invalidTag, invalidField} {
+		if _, err := MarshalJSON(v); err == nil {
+			t.Fatalf("Expected error with %+v, but got nil\n", v)
+		}
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	type period struct {
+		Start Carbon `json:"start" carbon:"format=Y年m月d日"`
+	}
+	type model struct {
+		Birthday  Carbon  `json:"birthday" carbon:"layout=2006-01-02"`
+		CreatedAt Carbon  `json:"created_at" carbon:"format=Y-m-d\\TH:i:s.vO"`
+		LoginAt   *Carbon `json:"login_at" carbon:"layout=15:04"`
+		Year      *Carbon `json:"year" carbon:"layout=2006"`
+		UpdatedAt Carbon  `json:"updated_at"`
+		DeletedAt Carbon  `json:"deleted_at" carbon:"layout=2006-01-02"`
+		Period    *period `json:"period"`
+		Name      string  `json:"name"`
+	}
+
+	data := []byte(`{"birthday":"2020-08-05","created_at":"2020-08-05T13:14:15.123+0800","login_at":"13:14","year":"2022","updated_at":"2020-08-05 13:14:15","deleted_at":"","period":{"start":"2020年08月05日"}}`)
+
+	// 按照字段已设置的时区解析，值为nil的*Carbon字段自动创建
+	prc := Timezone(PRC)
+	output := model{Birthday: prc, CreatedAt: prc, UpdatedAt: prc, DeletedAt: prc, Name: "carbon"}
+	if err := UnmarshalJSON(data, &output); err != nil {
+		t.Fatalf("Unexpected error %v\n", err)
+	}
+
+	if output.Birthday.ToDateTimeString() != "2020-08-05 00:00:00" || output.CreatedAt.ToFormatString("Y-m-d H:i:s.v") != "2020-08-05 13:14:15.123" || output.LoginAt.ToTimeString() != "13:14:00" || output.Year.ToDateString() != "2022-01-01" || output.UpdatedAt.ToDateTimeString() != "2020-08-05 13:14:15" || !output.DeletedAt.IsZero() || output.Period.Start.ToDateString() != "2020-08-05" || output.Name != "carbon" {
+		t.Fatalf("Unexpected output %+v\n", output)
+	}
+
+	if output.Birthday != prc.ParseByFormat("2020-08-05", "Y-m-d") {
+		t.Fatalf("Expected the field to be comparable, but got %+v\n", output.Birthday)
+	}
+
+	if result, _ := MarshalJSON(&output); string(result) != string(data)[:len(data)-1]+`,"name":"carbon"}` {
+		t.Fatalf("Expected a round trip, but got %s\n", result)
+	}
+
+	if err := UnmarshalJSON([]byte(`{"login_at":null,"period":null}`), &output); err != nil || output.LoginAt != nil || output.Period != nil || output.Year == nil {
+		t.Fatalf("Unexpected output %+v, error %v\n", output, err)
+	}
+
+	errorTests := []string{`{"birthday":"2020-08-05 13:14:15"}`, `{"period":{"start":"2020-08-05"}}`, `{"birthday":1}`, `xxx`}
+	for _, input := range errorTests {
+		if err := UnmarshalJSON([]byte(input), &output); err == nil {
+			t.Fatalf("Input %s, expected error, but got nil\n", input)
+		}
+	}
+
+	var invalid struct {
+		Birthday Carbon `carbon:"xxx"`
+	}
+	if UnmarshalJSON(data, &invalid) == nil || UnmarshalJSON(data, output) == nil || UnmarshalJSON(data, (*model)(nil)) == nil {
+		t.Fatal("Expected error with an invalid tag or value\n")
+	}
+}
+
+func TestCarbon_Scan(t *testing.T) {
+	Tests := []struct {
+		input  interface{} // 输入值
+		output string      // 期望输出值
+	}{
+		{nil, ""},
+		{time.Date(2020, 8, 5, 5, 14, 15, 0, time.UTC), "2020-08-05 13:14:15"},
+		{[]byte("2020-08-05 13:14:15"), "2020-08-05 13:14:15"},
+		{"2020-08-05 13:14:15.123456", "2020-08-05 13:14:15"},
+		{"2020-08-05", "2020-08-05 00:00:00"},
+		{"20200805131415", "2020-08-05 13:14:15"},
+		{"2020-08-05T05:14:15Z", "2020-08-05 13:14:15"},
+		{"0000-00-00 00:00:00", ""},
+		{[]byte(""), ""},
+		{int64(1596604455), "2020-08-05 13:14:15"},
+		{int64(1596604455123), "2020-08-05 13:14:15"},
+	}
+
+	for _, v := range Tests {
+		c := Now()
+		if err := c.Scan(v.input); err != nil {
+			t.Fatalf("Input %v, unexpected error %v\n", v.input, err)
+		}
+
+		if output := c.ToDateTimeString(); output != v.output || output != c.ToFormatString("Y-m-d H:i:s") {
+			t.Fatalf("Input %v, expected %s, but got %s\n", v.input, v.output, output)
+		}
+	}
+
+	errorTests := []interface{}{"xxx", []byte("2020-08-05 13"), 1.5, true}
+
+	for _, input := range errorTests {
+		var c Carbon
+		if err := c.Scan(input); err == nil {
+			t.Fatalf("Input %v, expected error, but got nil\n", input)
+		}
+	}
+}
+
+func TestCarbon_ScanWithTimezone(t *testing.T) {
+	c := Timezone(Tokyo).Now()
+	if err := c.Scan("2020-08-05 13:14:15"); err != nil {
+		t.Fatalf("Unexpected error %v\n", err)
+	}
+
+	if output := c.ToFormatString("Y-m-d H:i:s e"); output != "2020-08-05 13:14:15 Asia/Tokyo" {
+		t.Fatalf("Expected 2020-08-05 13:14:15 Asia/Tokyo, but got %s\n", output)
+	}
+
+	var zero Carbon
+	if err := zero.Scan(time.Date(2020, 8, 5, 5, 14, 15, 0, time.UTC)); err != nil || zero.Timezone(Tokyo).Error != nil {
+		t.Fatalf("Expected a valid location, but got error %v\n", err)
+	}
+
+	if output := zero.ToFormatString("e"); output != time.Local.String() {
+		t.Fatalf("Expected %s, but got %s\n", time.Local.String(), output)
+	}
+
+	millis := Now()
+	if err := millis.Scan(int64(1596604455123)); err != nil || millis.ToFormatString("v") != "123" {
+		t.Fatalf("Expected millisecond 123, but got %s\n", millis.ToFormatString("v"))
+	}
+}
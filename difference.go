@@ -0,0 +1,135 @@
+package carbon
+
+import (
+	"fmt"
+	"time"
+)
+
+// DiffInYears 相差多少年(按整年计算，如2020-02-29至2021-02-28不足一年)
+func (c Carbon) DiffInYears(end Carbon) int64 {
+	return c.DiffInMonths(end) / MonthsPerYear
+}
+
+// DiffInYearsWithAbs 相差多少年(绝对值)
+func (c Carbon) DiffInYearsWithAbs(end Carbon) int64 {
+	return abs(c.DiffInYears(end))
+}
+
+// DiffInMonths 相差多少月(按整月计算，如01-31至02-28不足一个月)
+func (c Carbon) DiffInMonths(end Carbon) int64 {
+	start, stop := c.Time, end.Time.In(c.Time.Location())
+	if stop.Before(start) {
+		return -end.DiffInMonths(c)
+	}
+
+	months := int64(stop.Year()-start.Year())*MonthsPerYear + int64(stop.Month()-start.Month())
+	// 与NextMonths一致不做月份溢出，结束日期的日和时刻未达到开始日期时最后一个月不足整月
+	if months > 0 && clockOfMonth(stop) < clockOfMonth(start) {
+		months--
+	}
+	return months
+}
+
+// DiffInMonthsWithAbs 相差多少月(绝对值)
+func (c Carbon) DiffInMonthsWithAbs(end Carbon) int64 {
+	return abs(c.DiffInMonths(end))
+}
+
+// DiffInQuarters 相差多少季度(按整季度计算)
+func (c Carbon) DiffInQuarters(end Carbon) int64 {
+	return c.DiffInMonths(end) / MonthsPerQuarter
+}
+
+// DiffInQuartersWithAbs 相差多少季度(绝对值)
+func (c Carbon) DiffInQuartersWithAbs(end Carbon) int64 {
+	return abs(c.DiffInQuarters(end))
+}
+
+// DiffInWeeks 相差多少周
+func (c Carbon) DiffInWeeks(end Carbon) int64 {
+	return c.DiffInDays(end) / DaysPerWeek
+}
+
+// DiffInWeeksWithAbs 相差多少周(绝对值)
+func (c Carbon) DiffInWeeksWithAbs(end Carbon) int64 {
+	return abs(c.DiffInWeeks(end))
+}
+
+// DiffInDays 相差多少天
+func (c Carbon) DiffInDays(end Carbon) int64 {
+	return c.DiffInHours(end) / HoursPerDay
+}
+
+// DiffInDaysWithAbs 相差多少天(绝对值)
+func (c Carbon) DiffInDaysWithAbs(end Carbon) int64 {
+	return abs(c.DiffInDays(end))
+}
+
+// DiffInHours 相差多少小时
+func (c Carbon) DiffInHours(end Carbon) int64 {
+	return int64(end.Time.Sub(c.Time) / time.Hour)
+}
+
+// DiffInHoursWithAbs 相差多少小时(绝对值)
+func (c Carbon) DiffInHoursWithAbs(end Carbon) int64 {
+	return abs(c.DiffInHours(end))
+}
+
+// DiffInMinutes 相差多少分钟
+func (c Carbon) DiffInMinutes(end Carbon) int64 {
+	return int64(end.Time.Sub(c.Time) / time.Minute)
+}
+
+// DiffInMinutesWithAbs 相差多少分钟(绝对值)
+func (c Carbon) DiffInMinutesWithAbs(end Carbon) int64 {
+	return abs(c.DiffInMinutes(end))
+}
+
+// DiffInSeconds 相差多少秒
+func (c Carbon) DiffInSeconds(end Carbon) int64 {
+	return int64(end.Time.Sub(c.Time) / time.Second)
+}
+
+// DiffInSecondsWithAbs 相差多少秒(绝对值)
+func (c Carbon) DiffInSecondsWithAbs(end Carbon) int64 {
+	return abs(c.DiffInSeconds(end))
+}
+
+// DiffForHumans 获取对人类友好的时间差，不传参数时与当前时间比较，如3天前、3 days from now
+func (c Carbon) DiffForHumans(end ...Carbon) string {
+	if c.Time.IsZero() {
+		return ""
+	}
+
+	past, future := "ago", "from_now"
+	other := c.Now()
+	if len(end) > 0 {
+		past, future = "before", "after"
+		other = end[0]
+	}
+
+	units := []struct {
+		key    string
+		number int64
+	}{
+		{"year", c.DiffInYears(other)},
+		{"month", c.DiffInMonths(other)},
+		{"week", c.DiffInWeeks(other)},
+		{"day", c.DiffInDays(other)},
+		{"hour", c.DiffInHours(other)},
+		{"minute", c.DiffInMinutes(other)},
+		{"second", c.DiffInSeconds(other)},
+	}
+
+	for _, unit := range units {
+		if unit.number == 0 {
+			continue
+		}
+		direction := past
+		if unit.number < 0 {
+			direction = future
+		}
+		return fmt.Sprintf(c.translate(direction), c.translateNumber(unit.key, abs(unit.number)))
+	}
+	return c.translate("now")
+}
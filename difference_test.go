@@ -0,0 +1,218 @@
+package carbon
+
+import "testing"
+
+var DiffTests = []struct {
+	start string // 开始时间
+	end   string // 结束时间
+}{
+	{"2020-08-05 13:14:15", "2020-08-05 13:14:15"},
+	{"2020-08-05 13:14:15", "2021-08-05 13:14:15"},
+	{"2021-08-05 13:14:15", "2020-08-05 13:14:15"},
+	{"2020-08-05 13:14:15", "2021-08-05 13:14:14"},
+	{"2021-01-31 13:14:15", "2021-02-28 13:14:15"},
+	{"2021-01-31 13:14:15", "2021-03-01 13:14:15"},
+	{"2020-02-29 13:14:15", "2021-02-28 13:14:15"},
+	{"2020-02-29 13:14:15", "2021-03-01 13:14:15"},
+	{"2020-08-05 13:14:15", "2020-07-28 13:14:15"},
+	{"2020-08-05 13:14:15", "2020-08-05 10:00:00"},
+}
+
+func TestCarbon_DiffInYears(t *testing.T) {
+	expected := []int64{0, 1, -1, 0, 0, 0, 0, 1, 0, 0}
+
+	for i, v := range DiffTests {
+		output := Parse(v.start).DiffInYears(Parse(v.end))
+
+		if output != expected[i] {
+			t.Fatalf("Input %s and %s, expected %d, but got %d", v.start, v.end, expected[i], output)
+		}
+
+		output = Parse(v.start).DiffInYearsWithAbs(Parse(v.end))
+
+		if output != abs(expected[i]) {
+			t.Fatalf("Input %s and %s, expected %d, but got %d", v.start, v.end, abs(expected[i]), output)
+		}
+	}
+}
+
+func TestCarbon_DiffInMonths(t *testing.T) {
+	expected := []int64{0, 12, -12, 11, 0, 1, 11, 12, 0, 0}
+
+	for i, v := range DiffTests {
+		output := Parse(v.start).DiffInMonths(Parse(v.end))
+
+		if output != expected[i] {
+			t.Fatalf("Input %s and %s, expected %d, but got %d", v.start, v.end, expected[i], output)
+		}
+
+		output = Parse(v.start).DiffInMonthsWithAbs(Parse(v.end))
+
+		if output != abs(expected[i]) {
+			t.Fatalf("Input %s and %s, expected %d, but got %d", v.start, v.end, abs(expected[i]), output)
+		}
+	}
+}
+
+func TestCarbon_DiffInQuarters(t *testing.T) {
+	expected := []int64{0, 4, -4, 3, 0, 0, 3, 4, 0, 0}
+
+	for i, v := range DiffTests {
+		output := Parse(v.start).DiffInQuarters(Parse(v.end))
+
+		if output != expected[i] {
+			t.Fatalf("Input %s and %s, expected %d, but got %d", v.start, v.end, expected[i], output)
+		}
+
+		output = Parse(v.start).DiffInQuartersWithAbs(Parse(v.end))
+
+		if output != abs(expected[i]) {
+			t.Fatalf("Input %s and %s, expected %d, but got %d", v.start, v.end, abs(expected[i]), output)
+		}
+	}
+}
+
+func TestCarbon_DiffInWeeks(t *testing.T) {
+	expected := []int64{0, 52, -52, 52, 4, 4, 52, 52, -1, 0}
+
+	for i, v := range DiffTests {
+		output := Parse(v.start).DiffInWeeks(Parse(v.end))
+
+		if output != expected[i] {
+			t.Fatalf("Input %s and %s, expected %d, but got %d", v.start, v.end, expected[i], output)
+		}
+
+		output = Parse(v.start).DiffInWeeksWithAbs(Parse(v.end))
+
+		if output != abs(expected[i]) {
+			t.Fatalf("Input %s and %s, expected %d, but got %d", v.start, v.end, abs(expected[i]), output)
+		}
+	}
+}
+
+func TestCarbon_DiffInDays(t *testing.T) {
+	expected := []int64{0, 365, -365, 364, 28, 29, 365, 366, -8, 0}
+
+	for i, v := range DiffTests {
+		output := Parse(v.start).DiffInDays(Parse(v.end))
+
+		if output != expected[i] {
+			t.Fatalf("Input %s and %s, expected %d, but got %d", v.start, v.end, expected[i], output)
+		}
+
+		output = Parse(v.start).DiffInDaysWithAbs(Parse(v.end))
+
+		if output != abs(expected[i]) {
+			t.Fatalf("Input %s and %s, expected %d, but got %d", v.start, v.end, abs(expected[i]), output)
+		}
+	}
+}
+
+func TestCarbon_DiffInHours(t *testing.T) {
+	expected := []int64{0, 8760, -8760, 8759, 672, 696, 8760, 8784, -192, -3}
+
+	for i, v := range DiffTests {
+		output := Parse(v.start).DiffInHours(Parse(v.end))
+
+		if output != expected[i] {
+			t.Fatalf("Input %s and %s, expected %d, but got %d", v.start, v.end, expected[i], output)
+		}
+
+		output = Parse(v.start).DiffInHoursWithAbs(Parse(v.end))
+
+		if output != abs(expected[i]) {
+			t.Fatalf("Input %s and %s, expected %d, but got %d", v.start, v.end, abs(expected[i]), output)
+		}
+	}
+}
+
+func TestCarbon_DiffInMinutes(t *testing.T) {
+	expected := []int64{0, 525600, -525600, 525599, 40320, 41760, 525600, 527040, -11520, -194}
+
+	for i, v := range DiffTests {
+		output := Parse(v.start).DiffInMinutes(Parse(v.end))
+
+		if output != expected[i] {
+			t.Fatalf("Input %s and %s, expected %d, but got %d", v.start, v.end, expected[i], output)
+		}
+
+		output = Parse(v.start).DiffInMinutesWithAbs(Parse(v.end))
+
+		if output != abs(expected[i]) {
+			t.Fatalf("Input %s and %s, expected %d, but got %d", v.start, v.end, abs(expected[i]), output)
+		}
+	}
+}
+
+func TestCarbon_DiffInSeconds(t *testing.T) {
+	expected := []int64{0, 31536000, -31536000, 31535999, 2419200, 2505600, 31536000, 31622400, -691200, -11655}
+
+	for i, v := range DiffTests {
+		output := Parse(v.start).DiffInSeconds(Parse(v.end))
+
+		if output != expected[i] {
+			t.Fatalf("Input %s and %s, expected %d, but got %d", v.start, v.end, expected[i], output)
+		}
+
+		output = Parse(v.start).DiffInSecondsWithAbs(Parse(v.end))
+
+		if output != abs(expected[i]) {
+			t.Fatalf("Input %s and %s, expected %d, but got %d", v.start, v.end, abs(expected[i]), output)
+		}
+	}
+}
+
+func TestCarbon_DiffForHumans1(t *testing.T) {
+	Tests := []struct {
+		input  Carbon // 输入值
+		locale string // 输入参数
+		output string // 期望输出值
+	}{
+		{Parse("0000-00-00 00:00:00"), English, ""},
+		{Now(), English, "just now"},
+		{Now().SubDays(3), English, "3 days ago"},
+		{Now().AddHours(2).AddMinute(), English, "2 hours from now"},
+		{Now().SubYear(), English, "1 year ago"},
+		{Now().AddDays(15), English, "2 weeks from now"},
+		{Now(), SimplifiedChinese, "刚刚"},
+		{Now().SubDays(3), SimplifiedChinese, "3天前"},
+		{Now().AddHours(2).AddMinute(), SimplifiedChinese, "2小时后"},
+		{Now().SubMonths(5).SubDay(), SimplifiedChinese, "5个月前"},
+	}
+
+	for _, v := range Tests {
+		output := v.input.Locale(v.locale).DiffForHumans()
+
+		if output != v.output {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input.ToDateTimeString(), v.output, output)
+		}
+	}
+}
+
+func TestCarbon_DiffForHumans2(t *testing.T) {
+	Tests := []struct {
+		start  string // 开始时间
+		end    string // 结束时间
+		locale string // 输入参数
+		output string // 期望输出值
+	}{
+		{"2020-08-05 13:14:15", "2020-08-05 13:14:15", English, "just now"},
+		{"2020-08-05 13:14:15", "2020-08-05 13:14:16", English, "1 second before"},
+		{"2020-08-05 13:14:15", "2020-08-05 13:14:05", English, "10 seconds after"},
+		{"2020-08-05 13:14:15", "2020-08-05 13:44:15", English, "30 minutes before"},
+		{"2020-08-05 13:14:15", "2020-08-08 13:14:15", English, "3 days before"},
+		{"2021-01-31 13:14:15", "2021-02-28 13:14:15", English, "4 weeks before"},
+		{"2021-03-01 13:14:15", "2021-01-31 13:14:15", English, "1 month after"},
+		{"2020-08-05 13:14:15", "2018-08-05 13:14:15", English, "2 years after"},
+		{"2020-08-05 13:14:15", "2020-08-08 13:14:15", SimplifiedChinese, "3天前"},
+		{"2020-08-05 13:14:15", "2018-08-05 13:14:15", SimplifiedChinese, "2年后"},
+	}
+
+	for _, v := range Tests {
+		output := Parse(v.start).Locale(v.locale).DiffForHumans(Parse(v.end))
+
+		if output != v.output {
+			t.Fatalf("Input %s and %s, expected %s, but got %s\n", v.start, v.end, v.output, output)
+		}
+	}
+}
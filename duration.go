@@ -0,0 +1,474 @@
+package carbon
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ISODuration ISO8601持续时间，如P1Y2M10DT2H30M，各部分可单独为负数
+type ISODuration struct {
+	Years       int
+	Months      int
+	Weeks       int
+	Days        int
+	Hours       int
+	Minutes     int
+	Seconds     int
+	Nanoseconds int
+}
+
+// ParseISODuration 解析ISO8601持续时间字符串，支持整体负号(如-P1D)、各部分负号(如P-1Y2M)及秒的小数部分(如PT1.5S)
+func ParseISODuration(value string) (ISODuration, error) {
+	d := ISODuration{}
+	s := value
+	negative := false
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		negative = s[0] == '-'
+		s = s[1:]
+	}
+	if len(s) < 2 || (s[0] != 'P' && s[0] != 'p') {
+		return d, invalidISODurationError(value)
+	}
+	s = strings.ToUpper(s[1:])
+
+	// 日期部分及时间部分允许的单位，须按顺序出现
+	units, inTime, found := "YMWD", false, false
+	for len(s) > 0 {
+		if s[0] == 'T' {
+			if inTime || len(s) == 1 {
+				return d, invalidISODurationError(value)
+			}
+			units, inTime, s = "HMS", true, s[1:]
+			continue
+		}
+		i := 0
+		if s[i] == '-' || s[i] == '+' {
+			i++
+		}
+		for i < len(s) && (s[i] >= '0' && s[i] <= '9' || s[i] == '.' || s[i] == ',') {
+			i++
+		}
+		if i == len(s) {
+			return d, invalidISODurationError(value)
+		}
+		number, unit := strings.Replace(s[:i], ",", ".", 1), s[i]
+		pos := strings.IndexByte(units, unit)
+		if pos < 0 {
+			return d, invalidISODurationError(value)
+		}
+		units, s = units[pos+1:], s[i+1:]
+
+		if inTime && unit == 'S' {
+			seconds, nanoseconds, err := parseISOSeconds(number)
+			if err != nil {
+				return d, invalidISODurationError(value)
+			}
+			d.Seconds, d.Nanoseconds, found = seconds, nanoseconds, true
+			continue
+		}
+		n, err := strconv.Atoi(number)
+		if err != nil {
+			return d, invalidISODurationError(value)
+		}
+		switch {
+		case !inTime && unit == 'Y':
+			d.Years = n
+		case !inTime && unit == 'M':
+			d.Months = n
+		case unit == 'W':
+			d.Weeks = n
+		case unit == 'D':
+			d.Days = n
+		case unit == 'H':
+			d.Hours = n
+		case inTime && unit == 'M':
+			d.Minutes = n
+		}
+		found = true
+	}
+	if !found {
+		return d, invalidISODurationError(value)
+	}
+	if negative {
+		d = d.Negate()
+	}
+	return d, nil
+}
+
+// String 输出ISO8601持续时间字符串，零值输出PT0S，各部分均不为正数时输出整体负号
+func (d ISODuration) String() string {
+	if d.IsZero() {
+		return "PT0S"
+	}
+	var b strings.Builder
+	if d.Years <= 0 && d.Months <= 0 && d.Weeks <= 0 && d.Days <= 0 && d.Hours <= 0 && d.Minutes <= 0 && d.Seconds <= 0 && d.Nanoseconds <= 0 {
+		b.WriteString("-")
+		d = d.Negate()
+	}
+	b.WriteString("P")
+	writeISOPart(&b, d.Years, "Y")
+	writeISOPart(&b, d.Months, "M")
+	writeISOPart(&b, d.Weeks, "W")
+	writeISOPart(&b, d.Days, "D")
+	if d.Hours == 0 && d.Minutes == 0 && d.Seconds == 0 && d.Nanoseconds == 0 {
+		return b.String()
+	}
+	b.WriteString("T")
+	writeISOPart(&b, d.Hours, "H")
+	writeISOPart(&b, d.Minutes, "M")
+	if d.Seconds != 0 || d.Nanoseconds != 0 {
+		nanoseconds := time.Duration(d.Seconds)*time.Second + time.Duration(d.Nanoseconds)
+		if nanoseconds < 0 {
+			b.WriteString("-")
+			nanoseconds = -nanoseconds
+		}
+		b.WriteString(strconv.FormatInt(int64(nanoseconds/time.Second), 10))
+		if fraction := nanoseconds % time.Second; fraction > 0 {
+			b.WriteString("." + strings.TrimRight(fmt.Sprintf("%09d", fraction), "0"))
+		}
+		b.WriteString("S")
+	}
+	return b.String()
+}
+
+// IsZero 是否是零值
+func (d ISODuration) IsZero() bool {
+	return d == ISODuration{}
+}
+
+// Negate 取反
+func (d ISODuration) Negate() ISODuration {
+	return ISODuration{-d.Years, -d.Months, -d.Weeks, -d.Days, -d.Hours, -d.Minutes, -d.Seconds, -d.Nanoseconds}
+}
+
+// duration 获取时、分、秒部分对应的固定时长
+func (d ISODuration) duration() time.Duration {
+	return time.Duration(d.Hours)*time.Hour + time.Duration(d.Minutes)*time.Minute + time.Duration(d.Seconds)*time.Second + time.Duration(d.Nanoseconds)
+}
+
+func (d ISODuration) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// UnmarshalJSON 解析JSON字符串，null和空字符串解析为零值
+func (d *ISODuration) UnmarshalJSON(data []byte) error {
+	value, isNull, err := unquoteJSON(data)
+	if err != nil {
+		return err
+	}
+	if isNull || value == "" {
+		*d = ISODuration{}
+		return nil
+	}
+	parsed, err := ParseISODuration(value)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// Value 实现driver.Valuer接口，以ISO8601字符串存储
+func (d ISODuration) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// Scan 实现sql.Scanner接口，支持string、[]byte和nil
+func (d *ISODuration) Scan(v interface{}) error {
+	switch value := v.(type) {
+	case nil:
+		*d = ISODuration{}
+		return nil
+	case []byte:
+		return d.UnmarshalJSON([]byte(strconv.Quote(string(value))))
+	case string:
+		return d.UnmarshalJSON([]byte(strconv.Quote(value)))
+	}
+	return invalidScanError(v)
+}
+
+// AddISODuration 按照ISO8601持续时间增加时间，年、月与NextMonths一致不做月份溢出，如P1M2D
+func (c Carbon) AddISODuration(duration string) Carbon {
+	if c.Error != nil {
+		return c
+	}
+	d, err := ParseISODuration(duration)
+	if err != nil {
+		return c.withError(err)
+	}
+	return c.addISODuration(d)
+}
+
+// SubISODuration 按照ISO8601持续时间减少时间
+func (c Carbon) SubISODuration(duration string) Carbon {
+	if c.Error != nil {
+		return c
+	}
+	d, err := ParseISODuration(duration)
+	if err != nil {
+		return c.withError(err)
+	}
+	return c.addISODuration(d.Negate())
+}
+
+// DiffAsISODuration 相差的ISO8601持续时间，满足c.AddISODuration(c.DiffAsISODuration(end).String())等于end
+// 结束时间早于开始时间时从开始时间向前推移，各部分均为负数
+func (c Carbon) DiffAsISODuration(end Carbon) ISODuration {
+	if c.Error != nil || end.Error != nil {
+		return ISODuration{}
+	}
+	stop := end.Time.In(c.location())
+	sign := 1
+	if stop.Before(c.Time) {
+		sign = -1
+	}
+	// reached 按推移方向判断是否未越过结束时间
+	reached := func(t time.Time) bool {
+		if sign > 0 {
+			return !t.After(stop)
+		}
+		return !t.Before(stop)
+	}
+
+	// 从开始时间起依次取整月、整天，剩余部分为时、分、秒
+	months := int(abs(c.DiffInMonths(end)))
+	for months > 0 && !reached(c.NextMonths(sign*months).Time) {
+		months--
+	}
+	for reached(c.NextMonths(sign * (months + 1)).Time) {
+		months++
+	}
+	anchor := c.NextMonths(sign * months).Time
+	days := int(abs(int64(stop.Sub(anchor).Hours() / HoursPerDay)))
+	for days > 0 && !reached(anchor.AddDate(0, 0, sign*days)) {
+		days--
+	}
+	for reached(anchor.AddDate(0, 0, sign*(days+1))) {
+		days++
+	}
+	rest := stop.Sub(anchor.AddDate(0, 0, sign*days))
+
+	return ISODuration{
+		Years:       sign * months / MonthsPerYear,
+		Months:      sign * months % MonthsPerYear,
+		Days:        sign * days,
+		Hours:       int(rest / time.Hour),
+		Minutes:     int(rest % time.Hour / time.Minute),
+		Seconds:     int(rest % time.Minute / time.Second),
+		Nanoseconds: int(rest % time.Second),
+	}
+}
+
+// addISODuration 依次增加年月、周日及时分秒
+func (c Carbon) addISODuration(d ISODuration) Carbon {
+	c = c.NextMonths(d.Years*MonthsPerYear + d.Months)
+	c.Time = c.Time.AddDate(0, 0, d.Weeks*DaysPerWeek+d.Days).Add(d.duration())
+	return c
+}
+
+// writeISOPart 输出ISO8601持续时间的非零部分
+func writeISOPart(b *strings.Builder, n int, unit string) {
+	if n != 0 {
+		b.WriteString(strconv.Itoa(n) + unit)
+	}
+}
+
+// parseISOSeconds 解析ISO8601持续时间的秒数，小数部分精确到纳秒
+func parseISOSeconds(number string) (int, int, error) {
+	sign := 1
+	if strings.HasPrefix(number, "-") || strings.HasPrefix(number, "+") {
+		if number[0] == '-' {
+			sign = -1
+		}
+		number = number[1:]
+	}
+	integer, fraction := number, ""
+	if pos := strings.IndexByte(number, '.'); pos >= 0 {
+		integer, fraction = number[:pos], number[pos+1:]
+	}
+	if integer == "" || len(fraction) > 9 || strings.ContainsAny(integer+fraction, "+-.") {
+		return 0, 0, invalidISODurationError(number)
+	}
+	seconds, err := strconv.Atoi(integer)
+	if err != nil {
+		return 0, 0, err
+	}
+	nanoseconds := 0
+	if fraction != "" {
+		if nanoseconds, err = strconv.Atoi(fraction + strings.Repeat("0", 9-len(fraction))); err != nil {
+			return 0, 0, err
+		}
+	}
+	return sign * seconds, sign * nanoseconds, nil
+}
+
+// 持续时间单位，键名为单位符号或单词(英文单词不区分大小写)，键值为标准单位
+var durationUnits = map[string]string{
+	"ns": "ns", "nanosecond": "ns", "nanoseconds": "ns", "纳秒": "ns",
+	"us": "us", "µs": "us", "μs": "us", "microsecond": "us", "microseconds": "us", "微秒": "us",
+	"ms": "ms", "millisecond": "ms", "milliseconds": "ms", "毫秒": "ms",
+	"s": "s", "sec": "s", "secs": "s", "second": "s", "seconds": "s", "秒": "s", "秒钟": "s",
+	"m": "m", "min": "m", "mins": "m", "minute": "m", "minutes": "m", "分": "m", "分钟": "m",
+	"h": "h", "hr": "h", "hrs": "h", "hour": "h", "hours": "h", "时": "h", "小时": "h",
+	"d": "d", "day": "d", "days": "d", "天": "d", "日": "d",
+	"w": "w", "week": "w", "weeks": "w", "周": "w", "星期": "w",
+	"mo": "mo", "month": "mo", "months": "mo", "月": "mo",
+	"y": "y", "yr": "y", "yrs": "y", "year": "y", "years": "y", "年": "y",
+}
+
+// 固定时长单位对应的时长
+var fixedDurationUnits = map[string]time.Duration{
+	"ns": time.Nanosecond,
+	"us": time.Microsecond,
+	"ms": time.Millisecond,
+	"s":  time.Second,
+	"m":  time.Minute,
+	"h":  time.Hour,
+	"d":  HoursPerDay * time.Hour,
+	"w":  HoursPerWeek * time.Hour,
+}
+
+// parseByDuration 解析持续时间字符串为日历时间间隔，天、周的整数部分按日历天数计算，小数部分按固定时长计算
+func parseByDuration(duration string) (CarbonInterval, error) {
+	i := CarbonInterval{}
+	s := strings.TrimSpace(duration)
+	// 开头的符号作用于整个持续时间，与time.ParseDuration一致
+	negative := strings.HasPrefix(s, "-")
+	if negative || strings.HasPrefix(s, "+") {
+		s = strings.TrimSpace(s[1:])
+	}
+	if s == "0" {
+		return i, nil
+	}
+	if s == "" {
+		return i, invalidDurationError(duration)
+	}
+
+	var fixed int64
+	for s != "" {
+		negativePart := false
+		if s[0] == '-' || s[0] == '+' {
+			negativePart = s[0] == '-'
+			s = strings.TrimSpace(s[1:])
+		}
+		pos := strings.IndexFunc(s, func(r rune) bool {
+			return (r < '0' || r > '9') && r != '.'
+		})
+		if pos < 0 {
+			pos = len(s)
+		}
+		// 整数与小数部分分开解析，避免浮点数丢失精度，与time.ParseDuration一致
+		integer, fraction, scale, ok := parseDurationNumber(s[:pos])
+		if !ok {
+			return i, invalidDurationError(duration)
+		}
+		s = strings.TrimSpace(s[pos:])
+
+		// 单位到空格、数字或符号为止，中文单位前可以有"个"，如1个月
+		end := strings.IndexFunc(s, func(r rune) bool {
+			return unicode.IsSpace(r) || unicode.IsDigit(r) || r == '.' || r == '-' || r == '+'
+		})
+		if end < 0 {
+			end = len(s)
+		}
+		// 大写M容易与月份混淆，不作为分钟单位
+		if s[:end] == "M" {
+			return i, invalidDurationError(duration)
+		}
+		word := strings.TrimPrefix(strings.ToLower(s[:end]), "个")
+		unit, ok := durationUnits[word]
+		if !ok {
+			return i, invalidDurationError(duration)
+		}
+		s = strings.TrimSpace(s[end:])
+
+		sign := int64(1)
+		if negativePart {
+			sign = -1
+		}
+		var nanoseconds int64
+		switch unit {
+		case "y", "mo", "d", "w":
+			if (unit == "y" || unit == "mo") && fraction != 0 {
+				return i, invalidDurationError(duration)
+			}
+			days, perDay := integer, int64(1)
+			if unit == "w" {
+				perDay = DaysPerWeek
+			}
+			if days > math.MaxInt32/perDay {
+				return i, invalidDurationError(duration)
+			}
+			days *= perDay
+			// 天、周的小数部分超过一天的部分仍按日历天数计算
+			if fraction != 0 {
+				nanoseconds = int64(float64(fraction) * (float64(perDay*int64(fixedDurationUnits["d"])) / scale))
+				days += nanoseconds / int64(fixedDurationUnits["d"])
+				nanoseconds %= int64(fixedDurationUnits["d"])
+			}
+			switch unit {
+			case "y":
+				i.Years += int(sign * days)
+			case "mo":
+				i.Months += int(sign * days)
+			default:
+				i.Days += int(sign * days)
+			}
+		default:
+			perUnit := int64(fixedDurationUnits[unit])
+			if integer > math.MaxInt64/perUnit {
+				return i, invalidDurationError(duration)
+			}
+			nanoseconds = integer * perUnit
+			if fraction != 0 {
+				part := int64(float64(fraction) * (float64(perUnit) / scale))
+				if nanoseconds > math.MaxInt64-part {
+					return i, invalidDurationError(duration)
+				}
+				nanoseconds += part
+			}
+		}
+		if sign > 0 && fixed > math.MaxInt64-nanoseconds || sign < 0 && fixed < math.MinInt64+nanoseconds {
+			return i, invalidDurationError(duration)
+		}
+		fixed += sign * nanoseconds
+	}
+
+	i.Nanoseconds = int(fixed)
+	if negative {
+		i = i.Negate()
+	}
+	return i, nil
+}
+
+// parseDurationNumber 解析持续时间中的数字，返回整数部分、小数部分及小数部分的倍数，如1.25返回1、25、100
+func parseDurationNumber(number string) (integer int64, fraction int64, scale float64, ok bool) {
+	digits, decimals := number, ""
+	if pos := strings.Index(number, "."); pos >= 0 {
+		digits, decimals = number[:pos], number[pos+1:]
+	}
+	if digits == "" && decimals == "" || strings.Contains(decimals, ".") {
+		return 0, 0, 0, false
+	}
+	for _, r := range digits {
+		if integer > (math.MaxInt64-9)/10 {
+			return 0, 0, 0, false
+		}
+		integer = integer*10 + int64(r-'0')
+	}
+	scale = 1
+	for _, r := range decimals {
+		// 超出精度的小数位直接忽略
+		if fraction > (math.MaxInt64-9)/10 {
+			break
+		}
+		fraction = fraction*10 + int64(r-'0')
+		scale *= 10
+	}
+	return integer, fraction, scale, true
+}
@@ -0,0 +1,200 @@
+package carbon
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseISODuration(t *testing.T) {
+	Tests := []struct {
+		input  string // 输入值
+		output string // 期望输出值
+	}{
+		{"P1Y2M10DT2H30M", "P1Y2M10DT2H30M"},
+		{"P2W", "P2W"},
+		{"p1dt12h", "P1DT12H"},
+		{"PT0S", "PT0S"},
+		{"P0D", "PT0S"},
+		{"PT1.5S", "PT1.5S"},
+		{"PT0,000000001S", "PT0.000000001S"},
+		{"PT1.123456789S", "PT1.123456789S"},
+		{"-P1DT2H", "-P1DT2H"},
+		{"-PT1.5S", "-PT1.5S"},
+		{"P-1Y2M", "P-1Y2M"},
+		{"P-1D", "-P1D"},
+		{"+P1M", "P1M"},
+		{"", ""},
+		{"P", ""},
+		{"1D", ""},
+		{"PT", ""},
+		{"P1DT", ""},
+		{"P1M1Y", ""},
+		{"P1H", ""},
+		{"PT1D", ""},
+		{"P1.5D", ""},
+		{"PT1.1234567891S", ""},
+		{"PT1.-5S", ""},
+		{"P1", ""},
+		{"PT1HT1M", ""},
+	}
+
+	for _, v := range Tests {
+		d, err := ParseISODuration(v.input)
+		output := d.String()
+		if err != nil {
+			output = ""
+		}
+
+		if output != v.output {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.output, output)
+		}
+	}
+}
+
+func TestCarbon_AddISODuration(t *testing.T) {
+	Tests := []struct {
+		input    string // 输入值
+		duration string // 输入参数
+		add      string // 期望增加后的输出值
+		sub      string // 期望减少后的输出值
+	}{
+		{"2020-01-31 13:14:15", "P1M", "2020-02-29 13:14:15", "2019-12-31 13:14:15"},
+		{"2020-08-05 13:14:15", "P1M2D", "2020-09-07 13:14:15", "2020-07-03 13:14:15"},
+		{"2020-02-29 13:14:15", "P1Y", "2021-02-28 13:14:15", "2019-02-28 13:14:15"},
+		{"2020-08-05 13:14:15", "P1WT1H30M", "2020-08-12 14:44:15", "2020-07-29 11:44:15"},
+		{"2020-08-05 13:14:15", "-PT1.5S", "2020-08-05 13:14:13", "2020-08-05 13:14:16"},
+		{"2020-08-05 13:14:15", "xxx", "", ""},
+	}
+
+	for _, v := range Tests {
+		add := Parse(v.input).AddISODuration(v.duration).ToDateTimeString()
+		sub := Parse(v.input).SubISODuration(v.duration).ToDateTimeString()
+
+		if add != v.add {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.add, add)
+		}
+
+		if sub != v.sub {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.sub, sub)
+		}
+	}
+
+	if Parse("xxx").AddISODuration("P1D").Error == nil || Parse("xxx").SubISODuration("P1D").Error == nil || Parse("2020-08-05").AddISODuration("xxx").Error == nil {
+		t.Fatal("Expected error to be returned\n")
+	}
+}
+
+func TestCarbon_DiffAsISODuration(t *testing.T) {
+	Tests := []struct {
+		input  string // 输入值
+		end    string // 输入参数
+		output string // 期望输出值
+	}{
+		{"2020-08-05 13:14:15", "2020-08-05 13:14:15", "PT0S"},
+		{"2020-08-05 13:14:15", "2021-10-15 15:44:15", "P1Y2M10DT2H30M"},
+		{"2020-01-31", "2020-02-29", "P1M"},
+		{"2020-01-31", "2020-03-01", "P1M1D"},
+		{"2020-08-05 13:14:15", "2020-08-06 13:14:14", "PT23H59M59S"},
+		{"2021-10-15 15:44:15", "2020-08-05 13:14:15", "-P1Y2M10DT2H30M"},
+		{"2021-03-31", "2021-02-28", "-P1M"},
+		{"2021-03-31", "2021-02-27", "-P1M1D"},
+		{"2020-05-31 10:00:00", "2020-04-30 09:00:00", "-P1MT1H"},
+		{"2020-03-31", "2020-02-29", "-P1M"},
+		{"2021-03-01", "2020-02-29", "-P1Y1D"},
+		{"xxx", "2020-08-05", "PT0S"},
+	}
+
+	for _, v := range Tests {
+		start, end := Parse(v.input), Parse(v.end)
+		d := start.DiffAsISODuration(end)
+
+		if d.String() != v.output {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.output, d.String())
+		}
+
+		if start.Error == nil && !start.AddISODuration(d.String()).Eq(end) {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.end, start.AddISODuration(d.String()).ToDateTimeString())
+		}
+	}
+
+	if Parse("2020-08-05").DiffAsISODuration(Parse("2020-08-05").AddNanoseconds(1500000000)).String() != "PT1.5S" {
+		t.Fatal("Expected fractional seconds to be kept\n")
+	}
+}
+
+func TestISODuration_Database(t *testing.T) {
+	type Task struct {
+		Retention ISODuration  `json:"retention"`
+		Timeout   *ISODuration `json:"timeout"`
+	}
+
+	var task Task
+	if err := json.Unmarshal([]byte(`{"retention": "P1M2D", "timeout": null}`), &task); err != nil {
+		t.Fatalf("Unexpected error %v\n", err)
+	}
+	output, _ := json.Marshal(task)
+	if string(output) != `{"retention":"P1M2D","timeout":null}` {
+		t.Fatalf("Expected %s, but got %s\n", `{"retention":"P1M2D","timeout":null}`, output)
+	}
+
+	if json.Unmarshal([]byte(`{"retention": "xxx"}`), &task) == nil || json.Unmarshal([]byte(`{"retention": ""}`), &task) != nil || !task.Retention.IsZero() {
+		t.Fatal("Expected invalid and empty durations to be handled\n")
+	}
+
+	var d ISODuration
+	Tests := []struct {
+		input  interface{} // 输入值
+		output string      // 期望输出值
+	}{
+		{"P1D", "P1D"},
+		{[]byte("PT2H"), "PT2H"},
+		{nil, "PT0S"},
+	}
+
+	for _, v := range Tests {
+		if err := d.Scan(v.input); err != nil || d.String() != v.output {
+			t.Fatalf("Input %v, expected %s, but got %s\n", v.input, v.output, d.String())
+		}
+
+		if value, _ := d.Value(); value != v.output {
+			t.Fatalf("Input %v, expected %s, but got %v\n", v.input, v.output, value)
+		}
+	}
+
+	if d.Scan(1) == nil || d.Scan("xxx") == nil {
+		t.Fatal("Expected error with an invalid value\n")
+	}
+}
+
+func TestParseByDuration(t *testing.T) {
+	Tests := []struct {
+		input  string         // 输入值
+		output CarbonInterval // 期望输出值
+	}{
+		{"5000h1ns", CarbonInterval{Nanoseconds: 5000*3600*1e9 + 1}},
+		{"2500000h0.000000001s", CarbonInterval{Nanoseconds: 2500000*3600*1e9 + 1}},
+		{"-2500000h0.000000001s", CarbonInterval{Nanoseconds: -2500000*3600*1e9 - 1}},
+		{"0.1s0.2s", CarbonInterval{Nanoseconds: 3e8}},
+		{"1.000000001s", CarbonInterval{Nanoseconds: 1e9 + 1}},
+		{".5m", CarbonInterval{Nanoseconds: 30e9}},
+		{"1.5w", CarbonInterval{Days: 10, Nanoseconds: 12 * 3600 * 1e9}},
+		{"1y-2mo", CarbonInterval{Years: 1, Months: -2}},
+		{"1m", CarbonInterval{Nanoseconds: 60e9}},
+		{"1 Minute", CarbonInterval{Nanoseconds: 60e9}},
+	}
+
+	for _, v := range Tests {
+		output, err := parseByDuration(v.input)
+
+		if err != nil || output != v.output {
+			t.Fatalf("Input %s, expected %+v, but got %+v %v\n", v.input, v.output, output, err)
+		}
+	}
+
+	// 大写M容易与月份混淆，不作为分钟单位
+	for _, input := range []string{"1M", "1y1M", ".", "1.2.3s", "2562048h", "2562047h3600s"} {
+		if _, err := parseByDuration(input); err == nil {
+			t.Fatalf("Input %s, expected error, but got nil\n", input)
+		}
+	}
+}
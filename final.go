@@ -12,17 +12,32 @@ func (c Carbon) ToTimestamp() int64 {
 	return c.Time.Unix()
 }
 
+// ToTimestampWithMillisecond 输出毫秒级时间戳
+func (c Carbon) ToTimestampWithMillisecond() int64 {
+	return c.Time.Unix()*MillisecondsPerSecond + int64(c.Time.Nanosecond())/int64(time.Millisecond)
+}
+
+// ToTimestampWithMicrosecond 输出微秒级时间戳
+func (c Carbon) ToTimestampWithMicrosecond() int64 {
+	return c.Time.Unix()*MicrosecondsPerSecond + int64(c.Time.Nanosecond())/int64(time.Microsecond)
+}
+
+// ToTimestampWithNanosecond 输出纳秒级时间戳，超出1678-2262年范围时结果未定义
+func (c Carbon) ToTimestampWithNanosecond() int64 {
+	return c.Time.UnixNano()
+}
+
 // Format ToFormatString的简称
 func (c Carbon) Format(format string) string {
 	return c.ToFormatString(format)
 }
 
-// ToFormatString 输出指定格式时间
+// ToFormatString 输出指定格式时间，月份、星期及上下午按照当前区域输出
 func (c Carbon) ToFormatString(format string) string {
 	if c.Time.IsZero() {
 		return ""
 	}
-	return c.Time.In(c.loc).Format(format2layout(format))
+	return c.format(c.Time.In(c.location()), format)
 }
 
 // ToDayDateTimeString 输出天数日期时间字符串
@@ -193,6 +208,38 @@ func (c Carbon) ToRFC7231String() string {
 	return c.Time.Format(RFC7231Format)
 }
 
+// ToMonthString 输出完整月份字符串(按照当前区域)
+func (c Carbon) ToMonthString() string {
+	if c.Time.IsZero() {
+		return ""
+	}
+	return c.translateItem("months", int(c.Time.Month())-1)
+}
+
+// ToShortMonthString 输出缩写月份字符串(按照当前区域)
+func (c Carbon) ToShortMonthString() string {
+	if c.Time.IsZero() {
+		return ""
+	}
+	return c.translateItem("short_months", int(c.Time.Month())-1)
+}
+
+// ToWeekString 输出完整星期字符串(按照当前区域)
+func (c Carbon) ToWeekString() string {
+	if c.Time.IsZero() {
+		return ""
+	}
+	return c.translateItem("weeks", int(c.Time.Weekday()))
+}
+
+// ToShortWeekString 输出缩写星期字符串(按照当前区域)
+func (c Carbon) ToShortWeekString() string {
+	if c.Time.IsZero() {
+		return ""
+	}
+	return c.translateItem("short_weeks", int(c.Time.Weekday()))
+}
+
 // DaysInYear 获取本年的总天数
 func (c Carbon) DaysInYear() int {
 	if c.Time.IsZero() {
@@ -245,25 +292,41 @@ func (c Carbon) DayOfWeek() int {
 	return int(c.Time.Weekday())
 }
 
-// WeekOfYear 获取本年的第几周
+// Millisecond 获取当前毫秒数
+func (c Carbon) Millisecond() int {
+	return c.Time.Nanosecond() / int(time.Millisecond)
+}
+
+// Microsecond 获取当前微秒数
+func (c Carbon) Microsecond() int {
+	return c.Time.Nanosecond() / int(time.Microsecond)
+}
+
+// Nanosecond 获取当前纳秒数
+func (c Carbon) Nanosecond() int {
+	return c.Time.Nanosecond()
+}
+
+// WeekOfYear 获取本年的第几周，每周起始日为周一时按照ISO-8601计算，否则包含1月1日的周为第1周
 func (c Carbon) WeekOfYear() int {
 	if c.Time.IsZero() {
 		return 0
 	}
-	_, week := c.Time.ISOWeek()
-	return week
+	if c.GetWeekStartsAt() == time.Monday {
+		_, week := c.Time.ISOWeek()
+		return week
+	}
+	first := time.Date(c.Time.Year(), 1, 1, 0, 0, 0, 0, c.Time.Location())
+	return (c.Time.YearDay()-1+c.dayOfWeek(first))/DaysPerWeek + 1
 }
 
-// WeekOfMonth 获取本月的第几周
+// WeekOfMonth 获取本月的第几周，包含1日的周为第1周
 func (c Carbon) WeekOfMonth() int {
 	if c.Time.IsZero() {
 		return 0
 	}
-	day := c.Time.Day()
-	if day < DaysPerWeek {
-		return 1
-	}
-	return day%DaysPerWeek + 1
+	first := time.Date(c.Time.Year(), c.Time.Month(), 1, 0, 0, 0, 0, c.Time.Location())
+	return (c.Time.Day()-1+c.dayOfWeek(first))/DaysPerWeek + 1
 }
 
 // IsZero 是否是零值
@@ -297,97 +360,97 @@ func (c Carbon) IsLeapYear() bool {
 
 // IsJanuary 是否是一月
 func (c Carbon) IsJanuary() bool {
-	return c.Time.In(c.loc).Month() == time.January
+	return c.Time.In(c.location()).Month() == time.January
 }
 
 // IsMonday 是否是二月
 func (c Carbon) IsFebruary() bool {
-	return c.Time.In(c.loc).Month() == time.February
+	return c.Time.In(c.location()).Month() == time.February
 }
 
 // IsMarch 是否是三月
 func (c Carbon) IsMarch() bool {
-	return c.Time.In(c.loc).Month() == time.March
+	return c.Time.In(c.location()).Month() == time.March
 }
 
 // IsApril 是否是四月
 func (c Carbon) IsApril() bool {
-	return c.Time.In(c.loc).Month() == time.April
+	return c.Time.In(c.location()).Month() == time.April
 }
 
 // IsMay 是否是五月
 func (c Carbon) IsMay() bool {
-	return c.Time.In(c.loc).Month() == time.May
+	return c.Time.In(c.location()).Month() == time.May
 }
 
 // IsJune 是否是六月
 func (c Carbon) IsJune() bool {
-	return c.Time.In(c.loc).Month() == time.June
+	return c.Time.In(c.location()).Month() == time.June
 }
 
 // IsJuly 是否是七月
 func (c Carbon) IsJuly() bool {
-	return c.Time.In(c.loc).Month() == time.July
+	return c.Time.In(c.location()).Month() == time.July
 }
 
 // IsAugust 是否是八月
 func (c Carbon) IsAugust() bool {
-	return c.Time.In(c.loc).Month() == time.August
+	return c.Time.In(c.location()).Month() == time.August
 }
 
 // IsSeptember 是否是九月
 func (c Carbon) IsSeptember() bool {
-	return c.Time.In(c.loc).Month() == time.September
+	return c.Time.In(c.location()).Month() == time.September
 }
 
 // IsOctober 是否是十月
 func (c Carbon) IsOctober() bool {
-	return c.Time.In(c.loc).Month() == time.October
+	return c.Time.In(c.location()).Month() == time.October
 }
 
 // IsNovember 是否是十一月
 func (c Carbon) IsNovember() bool {
-	return c.Time.In(c.loc).Month() == time.November
+	return c.Time.In(c.location()).Month() == time.November
 }
 
 // IsDecember 是否是十二月
 func (c Carbon) IsDecember() bool {
-	return c.Time.In(c.loc).Month() == time.December
+	return c.Time.In(c.location()).Month() == time.December
 }
 
 // IsMonday 是否是周一
 func (c Carbon) IsMonday() bool {
-	return c.Time.In(c.loc).Weekday() == time.Monday
+	return c.Time.In(c.location()).Weekday() == time.Monday
 }
 
 // IsTuesday 是否是周二
 func (c Carbon) IsTuesday() bool {
-	return c.Time.In(c.loc).Weekday() == time.Tuesday
+	return c.Time.In(c.location()).Weekday() == time.Tuesday
 }
 
 // IsWednesday 是否是周三
 func (c Carbon) IsWednesday() bool {
-	return c.Time.In(c.loc).Weekday() == time.Wednesday
+	return c.Time.In(c.location()).Weekday() == time.Wednesday
 }
 
 // IsThursday 是否是周四
 func (c Carbon) IsThursday() bool {
-	return c.Time.In(c.loc).Weekday() == time.Thursday
+	return c.Time.In(c.location()).Weekday() == time.Thursday
 }
 
 // IsFriday 是否是周五
 func (c Carbon) IsFriday() bool {
-	return c.Time.In(c.loc).Weekday() == time.Friday
+	return c.Time.In(c.location()).Weekday() == time.Friday
 }
 
 // IsSaturday 是否是周六
 func (c Carbon) IsSaturday() bool {
-	return c.Time.In(c.loc).Weekday() == time.Saturday
+	return c.Time.In(c.location()).Weekday() == time.Saturday
 }
 
 // IsSunday 是否是周日
 func (c Carbon) IsSunday() bool {
-	return c.Time.In(c.loc).Weekday() == time.Sunday
+	return c.Time.In(c.location()).Weekday() == time.Sunday
 }
 
 // IsWeekday 是否是工作日
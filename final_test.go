@@ -75,6 +75,28 @@ func TestCarbon_ToFormatString(t *testing.T) {
 		{"0000-00-00", "Y年m月d日", ""},
 		{"00:00:00", "Y年m月d日", ""},
 		{"2020-08-05 13:14:15", "Y年m月d日", "2020年08月05日"},
+		{"2020-08-05 13:14:15", "YmdHis", "20200805131415"},
+		{"2020-08-05 13:14:15", "Y-m-d Y/m/d", "2020-08-05 2020/08/05"},
+		{"2020-08-05 13:14:15", "Y-m-d \\a\\t H:i", "2020-08-05 at 13:14"},
+		{"2020-08-05 13:14:15", "\\Y\\\\Y", "Y\\2020"},
+		{"2020-08-05 13:14:15", "d D j l N S w z", "05 Wed 5 Wednesday 3 th 3 217"},
+		{"2020-08-01 13:14:15", "jS", "1st"},
+		{"2020-08-22 13:14:15", "jS", "22nd"},
+		{"2020-08-23 13:14:15", "jS", "23rd"},
+		{"2020-08-11 13:14:15", "jS", "11th"},
+		{"2020-08-09 13:14:15", "N w", "7 0"},
+		{"2021-01-01 13:14:15", "W o", "53 2020"},
+		{"2020-08-05 13:14:15", "W o", "32 2020"},
+		{"2020-02-05 13:14:15", "F m M n t L", "February 02 Feb 2 29 1"},
+		{"2021-02-05 13:14:15", "t L y", "28 0 21"},
+		{"2020-08-05 13:14:15", "a A g G h H i s", "pm PM 1 13 01 13 14 15"},
+		{"2020-08-05 00:04:05", "a A g G h H i s", "am AM 12 0 12 00 04 05"},
+		{"2020-08-05 13:14:15", "B u v", "259 000000 000"},
+		{"2020-08-05 13:14:15", "P p", "PM pm"},
+		{"2020-08-05 13:14:15", "e I O T Z", "Asia/Shanghai 0 +0800 CST 28800"},
+		{"2020-08-05 13:14:15", "c", "2020-08-05T13:14:15+08:00"},
+		{"2020-08-05 13:14:15", "r", "Wed, 05 Aug 2020 13:14:15 +0800"},
+		{"2020-08-05 13:14:15", "U", "1596604455"},
 	}
 
 	for _, v := range Tests {
@@ -84,6 +106,117 @@ func TestCarbon_ToFormatString(t *testing.T) {
 			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.output, output)
 		}
 	}
+
+	output := Timezone(Tokyo).Parse("2020-08-05 13:14:15").ToFormatString("Y-m-d H:i:s e T O")
+	if expected := "2020-08-05 14:14:15 Asia/Tokyo JST +0900"; output != expected {
+		t.Fatalf("Expected %s, but got %s\n", expected, output)
+	}
+}
+
+func TestCarbon_ToFormatStringWithLocale(t *testing.T) {
+	Tests := []struct {
+		input  string // 输入值
+		locale string // 输入参数
+		format string // 输入参数
+		output string // 期望输出值
+	}{
+		{"2020-08-05 13:14:15", English, "l, d F Y h:i:s P", "Wednesday, 05 August 2020 01:14:15 PM"},
+		{"2020-08-05 09:14:15", English, "D, M j Y h:i p", "Wed, Aug 5 2020 09:14 am"},
+		{"2020-08-05 13:14:15", SimplifiedChinese, "Y年F j日 l P h:i", "2020年八月 5日 星期三 下午 01:14"},
+		{"2020-08-05 09:14:15", SimplifiedChinese, "M d日 D P", "8月 05日 周三 上午"},
+	}
+
+	for _, v := range Tests {
+		output := Parse(v.input).Locale(v.locale).ToFormatString(v.format)
+
+		if output != v.output {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.output, output)
+		}
+	}
+}
+
+func TestCarbon_ToMonthString(t *testing.T) {
+	Tests := []struct {
+		input  string // 输入值
+		locale string // 输入参数
+		output string // 期望输出值
+	}{
+		{"0000-00-00", English, ""},
+		{"2020-08-05", English, "August"},
+		{"2020-05-05", English, "May"},
+		{"2020-08-05", SimplifiedChinese, "八月"},
+		{"2020-12-05", SimplifiedChinese, "十二月"},
+	}
+
+	for _, v := range Tests {
+		output := Parse(v.input).Locale(v.locale).ToMonthString()
+
+		if output != v.output {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.output, output)
+		}
+	}
+}
+
+func TestCarbon_ToShortMonthString(t *testing.T) {
+	Tests := []struct {
+		input  string // 输入值
+		locale string // 输入参数
+		output string // 期望输出值
+	}{
+		{"0000-00-00", English, ""},
+		{"2020-08-05", English, "Aug"},
+		{"2020-08-05", SimplifiedChinese, "8月"},
+	}
+
+	for _, v := range Tests {
+		output := Parse(v.input).Locale(v.locale).ToShortMonthString()
+
+		if output != v.output {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.output, output)
+		}
+	}
+}
+
+func TestCarbon_ToWeekString(t *testing.T) {
+	Tests := []struct {
+		input  string // 输入值
+		locale string // 输入参数
+		output string // 期望输出值
+	}{
+		{"0000-00-00", English, ""},
+		{"2020-08-05", English, "Wednesday"},
+		{"2020-08-09", English, "Sunday"},
+		{"2020-08-05", SimplifiedChinese, "星期三"},
+		{"2020-08-09", SimplifiedChinese, "星期日"},
+	}
+
+	for _, v := range Tests {
+		output := Parse(v.input).Locale(v.locale).ToWeekString()
+
+		if output != v.output {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.output, output)
+		}
+	}
+}
+
+func TestCarbon_ToShortWeekString(t *testing.T) {
+	Tests := []struct {
+		input  string // 输入值
+		locale string // 输入参数
+		output string // 期望输出值
+	}{
+		{"0000-00-00", English, ""},
+		{"2020-08-05", English, "Wed"},
+		{"2020-08-05", SimplifiedChinese, "周三"},
+	}
+
+	for _, v := range Tests {
+		output := Parse(v.input).Locale(v.locale).ToShortWeekString()
+
+		if output != v.output {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.output, output)
+		}
+	}
 }
 
 func TestCarbon_ToDayDateTimeString(t *testing.T) {
@@ -661,10 +794,14 @@ func TestCarbon_WeekOfMonth(t *testing.T) {
 	}{
 		{"0000-00-00", 0},
 		{"2020-01-01", 1},
-		{"2020-01-31", 4},
-		{"2020-02-28", 1},
-		{"2020-01-29", 2},
-		{"2020-08-05", 1},
+		{"2020-01-31", 5},
+		{"2020-02-28", 5},
+		{"2020-01-29", 5},
+		{"2020-08-05", 2},
+		{"2020-06-07", 1},
+		{"2020-06-08", 2},
+		{"2020-06-14", 2},
+		{"2020-06-30", 5},
 	}
 
 	for _, v := range Tests {
@@ -1636,3 +1773,25 @@ func TestCarbon_IsTomorrow(t *testing.T) {
 		}
 	}
 }
+
+func TestCarbon_ToTimestampWithPrecision(t *testing.T) {
+	c := CreateFromTimestampNano(1596604455123456789)
+
+	if c.ToTimestamp() != 1596604455 || c.ToTimestampWithMillisecond() != 1596604455123 || c.ToTimestampWithMicrosecond() != 1596604455123456 || c.ToTimestampWithNanosecond() != 1596604455123456789 {
+		t.Fatalf("Unexpected timestamps %d %d %d %d\n", c.ToTimestamp(), c.ToTimestampWithMillisecond(), c.ToTimestampWithMicrosecond(), c.ToTimestampWithNanosecond())
+	}
+
+	if c.Millisecond() != 123 || c.Microsecond() != 123456 || c.Nanosecond() != 123456789 {
+		t.Fatalf("Unexpected sub-second parts %d %d %d\n", c.Millisecond(), c.Microsecond(), c.Nanosecond())
+	}
+
+	c = CreateFromTimestampMilli(-1)
+	if c.ToTimestampWithMillisecond() != -1 || c.ToTimestampWithMicrosecond() != -1000 {
+		t.Fatalf("Unexpected negative timestamps %d %d\n", c.ToTimestampWithMillisecond(), c.ToTimestampWithMicrosecond())
+	}
+
+	c = Parse("")
+	if c.Millisecond() != 0 || c.Microsecond() != 0 || c.Nanosecond() != 0 {
+		t.Fatal("Expected zero sub-second parts for zero value\n")
+	}
+}
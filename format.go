@@ -0,0 +1,504 @@
+package carbon
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	// 本地时区的真实名称，首次使用时解析
+	localName     string
+	localNameOnce sync.Once
+)
+
+// format 按照格式化符号输出时间，月份、星期及上下午按照当前区域输出
+func (c Carbon) format(t time.Time, format string) string {
+	var b strings.Builder
+	for i := 0; i < len(format); {
+		r, size := utf8.DecodeRuneInString(format[i:])
+		i += size
+		if r == '\\' && i < len(format) {
+			r, size = utf8.DecodeRuneInString(format[i:])
+			i += size
+			b.WriteRune(r)
+			continue
+		}
+
+		switch r {
+		case 'd': // 日
+			b.WriteString(pad(t.Day(), 2))
+		case 'D': // 星期缩写
+			b.WriteString(c.translateItem("short_weeks", int(t.Weekday())))
+		case 'j': // 日
+			b.WriteString(strconv.Itoa(t.Day()))
+		case 'l': // 星期全称
+			b.WriteString(c.translateItem("weeks", int(t.Weekday())))
+		case 'N': // ISO-8601星期
+			b.WriteString(strconv.Itoa(isoWeekday(t)))
+		case 'S': // 日的英文序数后缀
+			b.WriteString(ordinalSuffix(t.Day()))
+		case 'w': // 星期
+			b.WriteString(strconv.Itoa(int(t.Weekday())))
+		case 'z': // 本年的第几天
+			b.WriteString(strconv.Itoa(t.YearDay() - 1))
+		case 'W': // ISO-8601周数
+			_, week := t.ISOWeek()
+			b.WriteString(pad(week, 2))
+		case 'F': // 月份全称
+			b.WriteString(c.translateItem("months", int(t.Month())-1))
+		case 'm': // 月份
+			b.WriteString(pad(int(t.Month()), 2))
+		case 'M': // 月份缩写
+			b.WriteString(c.translateItem("short_months", int(t.Month())-1))
+		case 'n': // 月份
+			b.WriteString(strconv.Itoa(int(t.Month())))
+		case 't': // 本月的总天数
+			b.WriteString(strconv.Itoa(daysInMonth(t.Year(), t.Month())))
+		case 'L': // 是否是闰年
+			b.WriteString(boolToNumber(isLeapYear(t.Year())))
+		case 'o': // ISO-8601周数所属的年份
+			year, _ := t.ISOWeek()
+			b.WriteString(strconv.Itoa(year))
+		case 'Y': // 年份
+			b.WriteString(pad(t.Year(), 4))
+		case 'y': // 年份
+			b.WriteString(pad(t.Year()%100, 2))
+		case 'a', 'p': // 小写上下午
+			b.WriteString(strings.ToLower(c.translateItem("meridiem", t.Hour()/12)))
+		case 'A', 'P': // 大写上下午
+			b.WriteString(c.translateItem("meridiem", t.Hour()/12))
+		case 'B': // Swatch网络时间
+			b.WriteString(pad(swatch(t), 3))
+		case 'g': // 12小时制小时
+			b.WriteString(strconv.Itoa(hour12(t.Hour())))
+		case 'G': // 24小时制小时
+			b.WriteString(strconv.Itoa(t.Hour()))
+		case 'h': // 12小时制小时
+			b.WriteString(pad(hour12(t.Hour()), 2))
+		case 'H': // 24小时制小时
+			b.WriteString(pad(t.Hour(), 2))
+		case 'i': // 分钟
+			b.WriteString(pad(t.Minute(), 2))
+		case 's': // 秒钟
+			b.WriteString(pad(t.Second(), 2))
+		case 'u': // 微秒
+			b.WriteString(pad(t.Nanosecond()/1e3, 6))
+		case 'v': // 毫秒
+			b.WriteString(pad(t.Nanosecond()/1e6, 3))
+		case 'e': // 时区标识
+			b.WriteString(locationName(t))
+		case 'I': // 是否是夏令时
+			b.WriteString(boolToNumber(isDST(t)))
+		case 'O': // 与UTC的时差
+			b.WriteString(t.Format("-0700"))
+		case 'T': // 时区缩写
+			b.WriteString(t.Format("MST"))
+		case 'Z': // 与UTC的时差秒数
+			_, offset := t.Zone()
+			b.WriteString(strconv.Itoa(offset))
+		case 'c': // ISO-8601日期
+			b.WriteString(t.Format("2006-01-02T15:04:05-07:00"))
+		case 'r': // RFC-2822日期
+			b.WriteString(t.Format("Mon, 02 Jan 2006 15:04:05 -0700"))
+		case 'U': // 时间戳
+			b.WriteString(strconv.FormatInt(t.Unix(), 10))
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// parseByFormat 按照格式化符号解析时间字符串，不含时区的时间按照当前实例的时区解析，月份、星期及上下午同时支持当前区域与英语
+func (c Carbon) parseByFormat(value string, format string) (time.Time, error) {
+	year, month, day, hour, minute, second, nanosecond := 0, 1, 1, 0, 0, 0, 0
+	yearDay, meridiem, timestamp := -1, -1, int64(0)
+	hasTimestamp, hasOffset, is12Hour := false, false, false
+	var loc *time.Location
+
+	pos := 0
+	fail := func() (time.Time, error) {
+		return time.Time{}, invalidFormatError(value, format)
+	}
+
+	// 组合格式化符号展开后解析
+	expanded := expandFormat(format)
+	for i := 0; i < len(expanded); {
+		r, size := utf8.DecodeRuneInString(expanded[i:])
+		i += size
+		if r == '\\' && i < len(expanded) {
+			r, size = utf8.DecodeRuneInString(expanded[i:])
+			i += size
+			if !strings.HasPrefix(value[pos:], string(r)) {
+				return fail()
+			}
+			pos += size
+			continue
+		}
+
+		var ok bool
+		switch r {
+		case 'd': // 日
+			day, pos, ok = parseNumber(value, pos, 2, 2)
+		case 'j': // 日
+			day, pos, ok = parseNumber(value, pos, 1, 2)
+		case 'D': // 星期缩写
+			_, pos, ok = c.parseLocaleName(value, pos, "short_weeks")
+		case 'l': // 星期全称
+			_, pos, ok = c.parseLocaleName(value, pos, "weeks")
+		case 'N', 'w', 'L', 'I': // ISO-8601星期 / 星期 / 是否是闰年 / 是否是夏令时
+			_, pos, ok = parseNumber(value, pos, 1, 1)
+		case 'S': // 日的英文序数后缀
+			_, pos, ok = parseName(value, pos, []string{"st", "nd", "rd", "th"})
+		case 'z': // 本年的第几天
+			yearDay, pos, ok = parseNumber(value, pos, 1, 3)
+		case 'W', 't': // ISO-8601周数 / 本月的总天数
+			_, pos, ok = parseNumber(value, pos, 1, 2)
+		case 'o': // ISO-8601周数所属的年份
+			_, pos, ok = parseNumber(value, pos, 1, 4)
+		case 'F': // 月份全称
+			month, pos, ok = c.parseLocaleName(value, pos, "months")
+			month++
+		case 'M': // 月份缩写
+			month, pos, ok = c.parseLocaleName(value, pos, "short_months")
+			month++
+		case 'm': // 月份
+			month, pos, ok = parseNumber(value, pos, 2, 2)
+		case 'n': // 月份
+			month, pos, ok = parseNumber(value, pos, 1, 2)
+		case 'Y': // 年份
+			year, pos, ok = parseNumber(value, pos, 4, 4)
+		case 'y': // 年份
+			year, pos, ok = parseNumber(value, pos, 2, 2)
+			// 与标准库保持一致，69-99表示19xx年，00-68表示20xx年
+			if year >= 69 {
+				year += 1900
+			} else {
+				year += 2000
+			}
+		case 'a', 'A', 'p', 'P': // 小写上下午 / 大写上下午
+			meridiem, pos, ok = c.parseLocaleName(value, pos, "meridiem")
+		case 'B': // Swatch网络时间
+			_, pos, ok = parseNumber(value, pos, 3, 3)
+		case 'g': // 12小时制小时
+			hour, pos, ok = parseNumber(value, pos, 1, 2)
+			is12Hour = true
+		case 'h': // 12小时制小时
+			hour, pos, ok = parseNumber(value, pos, 2, 2)
+			is12Hour = true
+		case 'G': // 24小时制小时
+			hour, pos, ok = parseNumber(value, pos, 1, 2)
+		case 'H': // 24小时制小时
+			hour, pos, ok = parseNumber(value, pos, 2, 2)
+		case 'i': // 分钟
+			minute, pos, ok = parseNumber(value, pos, 2, 2)
+		case 's': // 秒钟
+			second, pos, ok = parseNumber(value, pos, 2, 2)
+		case 'u': // 微秒
+			nanosecond, pos, ok = parseNumber(value, pos, 1, 6)
+			nanosecond *= 1e3
+		case 'v': // 毫秒
+			nanosecond, pos, ok = parseNumber(value, pos, 1, 3)
+			nanosecond *= 1e6
+		case 'e': // 时区标识
+			end := pos + strings.IndexFunc(value[pos:]+" ", func(r rune) bool {
+				return !(r == '/' || r == '_' || r == '-' || r == '+' || r >= '0' && r <= '9' || r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z')
+			})
+			var err error
+			loc, err = time.LoadLocation(value[pos:end])
+			pos, ok = end, err == nil && end > pos
+		case 'O': // 与UTC的时差
+			var offset int
+			offset, pos, ok = parseOffset(value, pos)
+			loc, hasOffset = time.FixedZone("", offset), true
+		case 'Z': // 与UTC的时差秒数
+			var offset int
+			sign := 1
+			if strings.HasPrefix(value[pos:], "-") {
+				sign, pos = -1, pos+1
+			}
+			offset, pos, ok = parseNumber(value, pos, 1, 5)
+			loc, hasOffset = time.FixedZone("", sign*offset), true
+		case 'T': // 时区缩写
+			end := pos + strings.IndexFunc(value[pos:]+" ", func(r rune) bool {
+				return !(r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z')
+			})
+			loc, ok = parseAbbrTimezone(value[pos:end]), end > pos
+			ok = ok && loc != nil
+			pos = end
+		case 'U': // 时间戳
+			end := pos
+			if strings.HasPrefix(value[end:], "-") {
+				end++
+			}
+			for end < len(value) && value[end] >= '0' && value[end] <= '9' {
+				end++
+			}
+			var err error
+			timestamp, err = strconv.ParseInt(value[pos:end], 10, 64)
+			hasTimestamp, pos, ok = true, end, err == nil
+		default:
+			ok = strings.HasPrefix(value[pos:], string(r))
+			pos += size
+		}
+		if !ok {
+			return fail()
+		}
+	}
+	if pos != len(value) {
+		return fail()
+	}
+
+	if loc == nil {
+		loc = c.location()
+	}
+	if hasTimestamp {
+		return time.Unix(timestamp, 0).In(loc), nil
+	}
+
+	if is12Hour {
+		if hour < 1 || hour > 12 {
+			return fail()
+		}
+		hour %= 12
+	}
+	if meridiem == 1 {
+		hour += 12
+	}
+	if yearDay >= 0 {
+		if yearDay >= daysInYear(year) {
+			return fail()
+		}
+		t := time.Date(year, 1, 1+yearDay, 0, 0, 0, 0, time.UTC)
+		month, day = int(t.Month()), t.Day()
+	}
+	if month < 1 || month > MonthsPerYear || day < 1 || day > daysInMonth(year, time.Month(month)) || hour > 23 || minute > 59 || second > 59 {
+		return fail()
+	}
+
+	t := time.Date(year, time.Month(month), day, hour, minute, second, nanosecond, loc)
+	// 时差与当前实例时区一致时使用当前实例时区
+	if _, offset := t.Zone(); hasOffset {
+		if _, localOffset := t.In(c.location()).Zone(); localOffset == offset {
+			t = t.In(c.location())
+		}
+	}
+	return t, nil
+}
+
+// parseLocaleName 按照当前区域及默认区域解析名称，返回名称序号
+func (c Carbon) parseLocaleName(value string, pos int, key string) (int, int, bool) {
+	if index, next, ok := parseName(value, pos, strings.Split(c.translate(key), "|")); ok {
+		return index, next, ok
+	}
+	return parseName(value, pos, strings.Split(Carbon{locale: DefaultLocale}.translate(key), "|"))
+}
+
+// expandFormat 将组合格式化符号c、r展开为基础格式化符号
+func expandFormat(format string) string {
+	var b strings.Builder
+	for i := 0; i < len(format); i++ {
+		switch format[i] {
+		case '\\':
+			b.WriteByte(format[i])
+			if i+1 < len(format) {
+				i++
+				b.WriteByte(format[i])
+			}
+		case 'c':
+			b.WriteString(`Y-m-d\TH:i:sO`)
+		case 'r':
+			b.WriteString("D, d M Y H:i:s O")
+		default:
+			b.WriteByte(format[i])
+		}
+	}
+	return b.String()
+}
+
+// parseName 解析名称列表中最长匹配的名称(不区分大小写)，返回名称序号
+func parseName(value string, pos int, names []string) (int, int, bool) {
+	index, length := -1, 0
+	for i, name := range names {
+		if len(name) > length && len(value)-pos >= len(name) && strings.EqualFold(value[pos:pos+len(name)], name) {
+			index, length = i, len(name)
+		}
+	}
+	return index, pos + length, index >= 0
+}
+
+// parseNumber 解析最少min位、最多max位的数字
+func parseNumber(value string, pos int, min int, max int) (int, int, bool) {
+	end := pos
+	for end < len(value) && end-pos < max && value[end] >= '0' && value[end] <= '9' {
+		end++
+	}
+	if end-pos < min {
+		return 0, pos, false
+	}
+	number, err := strconv.Atoi(value[pos:end])
+	return number, end, err == nil
+}
+
+// parseOffset 解析与UTC的时差，支持Z、+0800、+08:00及+08格式，返回秒数
+func parseOffset(value string, pos int) (int, int, bool) {
+	if strings.HasPrefix(value[pos:], "Z") {
+		return 0, pos + 1, true
+	}
+	if pos >= len(value) || (value[pos] != '+' && value[pos] != '-') {
+		return 0, pos, false
+	}
+	sign := 1
+	if value[pos] == '-' {
+		sign = -1
+	}
+	hours, next, ok := parseNumber(value, pos+1, 2, 2)
+	if !ok {
+		return 0, pos, false
+	}
+	if strings.HasPrefix(value[next:], ":") {
+		next++
+	}
+	minutes, end, ok := parseNumber(value, next, 2, 2)
+	if !ok {
+		minutes, end = 0, next
+	}
+	return sign * (hours*3600 + minutes*60), end, true
+}
+
+// parseAbbrTimezone 解析时区缩写，无法识别时返回nil
+func parseAbbrTimezone(abbr string) *time.Location {
+	if abbr == "Z" || abbr == UTC || abbr == GMT {
+		return time.UTC
+	}
+	if name, _ := time.Now().In(time.Local).Zone(); name == abbr {
+		return time.Local
+	}
+	if loc, err := time.LoadLocation(abbr); err == nil {
+		return loc
+	}
+	return nil
+}
+
+// pad 数字补足前导零至指定位数
+func pad(number int, width int) string {
+	if number < 0 {
+		return "-" + pad(-number, width)
+	}
+	s := strconv.Itoa(number)
+	if len(s) < width {
+		s = strings.Repeat("0", width-len(s)) + s
+	}
+	return s
+}
+
+// hour12 24小时制转12小时制
+func hour12(hour int) int {
+	if hour%12 == 0 {
+		return 12
+	}
+	return hour % 12
+}
+
+// isoWeekday 获取ISO-8601星期，周一为1，周日为7
+func isoWeekday(t time.Time) int {
+	if t.Weekday() == time.Sunday {
+		return DaysPerWeek
+	}
+	return int(t.Weekday())
+}
+
+// ordinalSuffix 获取日的英文序数后缀
+func ordinalSuffix(day int) string {
+	if day >= 11 && day <= 13 {
+		return "th"
+	}
+	switch day % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	}
+	return "th"
+}
+
+// swatch 获取Swatch网络时间
+func swatch(t time.Time) int {
+	u := t.UTC()
+	seconds := (u.Hour()*3600 + u.Minute()*60 + u.Second() + 3600) % SecondsPerDay
+	return seconds * 1000 / SecondsPerDay
+}
+
+// isDST 是否是夏令时，时差大于本年冬夏两季中较小的时差即为夏令时
+func isDST(t time.Time) bool {
+	_, january := time.Date(t.Year(), 1, 1, 0, 0, 0, 0, t.Location()).Zone()
+	_, july := time.Date(t.Year(), 7, 1, 0, 0, 0, 0, t.Location()).Zone()
+	_, offset := t.Zone()
+	if january < july {
+		return offset > january
+	}
+	return offset > july
+}
+
+// locationName 获取时区标识，本地时区按照TZ环境变量或/etc/localtime解析出真实名称，无法解析时返回时区缩写
+func locationName(t time.Time) string {
+	if name := t.Location().String(); name != Local {
+		return name
+	}
+	localNameOnce.Do(func() {
+		path, ok := os.LookupEnv("TZ")
+		switch {
+		case ok && strings.TrimPrefix(path, ":") == "":
+			localName = "UTC"
+			return
+		case ok:
+			path = strings.TrimPrefix(path, ":")
+		default:
+			path, _ = filepath.EvalSymlinks("/etc/localtime")
+		}
+		if i := strings.LastIndex(path, "zoneinfo/"); i >= 0 {
+			path = path[i+len("zoneinfo/"):]
+		}
+		if _, err := time.LoadLocation(path); err == nil && path != "" && !filepath.IsAbs(path) {
+			localName = path
+		}
+	})
+	if localName != "" {
+		return localName
+	}
+	abbr, _ := t.Zone()
+	return abbr
+}
+
+// boolToNumber 布尔值转数字字符串
+func boolToNumber(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
+
+// isLeapYear 是否是闰年
+func isLeapYear(year int) bool {
+	return year%400 == 0 || (year%4 == 0 && year%100 != 0)
+}
+
+// daysInYear 获取年份的总天数
+func daysInYear(year int) int {
+	if isLeapYear(year) {
+		return DaysPerLeapYear
+	}
+	return DaysPerNormalYear
+}
+
+// daysInMonth 获取月份的总天数
+func daysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,162 @@
+package carbon
+
+import (
+	"sync"
+	"time"
+)
+
+// GanZhiYearBoundary 干支纪年的换年时刻
+type GanZhiYearBoundary int
+
+const (
+	BeginningOfSpring GanZhiYearBoundary = iota + 1 // 以立春交节时刻换年
+	LunarNewYear                                    // 以农历正月初一换年
+)
+
+var (
+	// 全局干支纪年换年时刻
+	globalGanZhiYearBoundary = BeginningOfSpring
+
+	// 干支纪年换年时刻读写锁
+	ganZhiMutex sync.RWMutex
+)
+
+// SetGanZhiYearBoundary 设置全局干支纪年换年时刻，默认以立春换年
+func SetGanZhiYearBoundary(boundary GanZhiYearBoundary) error {
+	if boundary != BeginningOfSpring && boundary != LunarNewYear {
+		return invalidGanZhiYearBoundaryError(boundary)
+	}
+	ganZhiMutex.Lock()
+	defer ganZhiMutex.Unlock()
+	globalGanZhiYearBoundary = boundary
+	return nil
+}
+
+// GetGanZhiYearBoundary 获取全局干支纪年换年时刻
+func GetGanZhiYearBoundary() GanZhiYearBoundary {
+	ganZhiMutex.RLock()
+	defer ganZhiMutex.RUnlock()
+	return globalGanZhiYearBoundary
+}
+
+// SetGanZhiYearBoundary 设置干支纪年换年时刻(仅对当前实例有效)
+func (c Carbon) SetGanZhiYearBoundary(boundary GanZhiYearBoundary) Carbon {
+	if c.Error != nil {
+		return c
+	}
+	if boundary != BeginningOfSpring && boundary != LunarNewYear {
+		return c.withError(invalidGanZhiYearBoundaryError(boundary))
+	}
+	c.ganZhiYearBoundary = boundary
+	return c
+}
+
+// GetGanZhiYearBoundary 获取当前实例干支纪年换年时刻，未设置时返回全局干支纪年换年时刻
+func (c Carbon) GetGanZhiYearBoundary() GanZhiYearBoundary {
+	if c.ganZhiYearBoundary == 0 {
+		return GetGanZhiYearBoundary()
+	}
+	return c.ganZhiYearBoundary
+}
+
+// GanZhiYear 获取年柱，如庚子，超出1900-2100年范围时返回空字符串
+// 年柱、月柱、日柱及时柱均按照北京时间计算，与实例时区无关
+func (c Carbon) GanZhiYear() string {
+	if !c.hasGanZhi() {
+		return ""
+	}
+	year := c.springYear()
+	if c.GetGanZhiYearBoundary() == LunarNewYear {
+		b := c
+		b.Time = c.beijingTime()
+		l := b.Lunar()
+		if l.IsZero() {
+			return ""
+		}
+		year = l.Year()
+	}
+	return ganZhi(year - 4)
+}
+
+// GanZhiMonth 获取月柱，以节气中的"节"换月，如立春起寅月
+func (c Carbon) GanZhiMonth() string {
+	if !c.hasGanZhi() {
+		return ""
+	}
+	year, month := c.springYear(), 0
+	for index := len(SolarTermNames) - 2; index >= 0; index -= 2 {
+		if !solarTermTime(c.termYear(), index).After(c.Time) {
+			// 立春为寅月(第1月)，小寒为丑月(第12月)
+			month = (index/2+11)%MonthsPerYear + 1
+			break
+		}
+	}
+	if month == 0 {
+		// 早于本年小寒，属于上年大雪所在的子月
+		month = 11
+	}
+	// 五虎遁：甲己之年丙作首
+	stem := ((year-4)%10*2 + 2 + month - 1) % 10
+	branch := (month + 1) % 12
+	return HeavenlyStems[(stem+4)%10] + EarthlyBranches[(branch+4)%12]
+}
+
+// GanZhiDay 获取日柱，以北京时间零点换日
+func (c Carbon) GanZhiDay() string {
+	if !c.hasGanZhi() {
+		return ""
+	}
+	return ganZhi(c.dayOfGanZhi())
+}
+
+// GanZhiHour 获取时柱，北京时间23点起为次日子时
+func (c Carbon) GanZhiHour() string {
+	if !c.hasGanZhi() {
+		return ""
+	}
+	day, hour := c.dayOfGanZhi(), c.beijingTime().Hour()
+	branch := (hour + 1) / 2 % 12
+	if hour == 23 {
+		day++
+	}
+	// 五鼠遁：甲己还加甲
+	stem := (day%10*2 + branch) % 10
+	return HeavenlyStems[(stem+4)%10] + EarthlyBranches[(branch+4)%12]
+}
+
+// hasGanZhi 是否在干支支持的范围内
+func (c Carbon) hasGanZhi() bool {
+	if c.Time.IsZero() {
+		return false
+	}
+	year := c.termYear()
+	return year >= minSolarTermYear && year <= maxSolarTermYear
+}
+
+// termYear 获取北京时间的公历年
+func (c Carbon) termYear() int {
+	return c.beijingTime().Year()
+}
+
+// springYear 获取以立春换年的年份
+func (c Carbon) springYear() int {
+	year := c.termYear()
+	if c.Time.Before(solarTermTime(year, 2)) {
+		return year - 1
+	}
+	return year
+}
+
+// dayOfGanZhi 获取北京时间当天的日干支在六十甲子中的序号，甲子为0
+func (c Carbon) dayOfGanZhi() int {
+	year, month, day := c.beijingTime().Date()
+	// 儒略日数加49即为六十甲子序号，如2000-01-01为戊午日
+	days := int(julianDay(time.Date(year, month, day, 12, 0, 0, 0, time.UTC)))
+	return (days + 49) % 60
+}
+
+// ganZhi 根据六十甲子序号获取干支，甲子为0
+func ganZhi(index int) string {
+	index = (index%60 + 60) % 60
+	return HeavenlyStems[(index%10+4)%10] + EarthlyBranches[(index%12+4)%12]
+}
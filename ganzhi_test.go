@@ -0,0 +1,75 @@
+package carbon
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCarbon_GanZhi(t *testing.T) {
+	Tests := []struct {
+		input  string // 输入值
+		output string // 期望输出值(年柱 月柱 日柱 时柱)
+	}{
+		{"0000-00-00", "   "},
+		{"1899-06-01", "   "},
+		{"2000-01-01 12:00:00", "己卯 丙子 戊午 戊午"},
+		{"1984-02-04 23:30:00", "甲子 丙寅 戊辰 甲子"},
+		{"2020-01-20 23:30:00", "己亥 丁丑 壬戌 壬子"},
+		{"2020-02-04 17:00:00", "己亥 丁丑 丁丑 己酉"},
+		{"2020-02-04 17:10:00", "庚子 戊寅 丁丑 己酉"},
+		{"2020-08-05 13:14:15", "庚子 癸未 庚辰 癸未"},
+		{"2021-01-01 00:30:00", "庚子 戊子 己酉 甲子"},
+		{"2021-01-05 12:00:00", "庚子 己丑 癸丑 戊午"},
+	}
+
+	for _, v := range Tests {
+		c := parseInBeijing(v.input)
+		output := c.GanZhiYear() + " " + c.GanZhiMonth() + " " + c.GanZhiDay() + " " + c.GanZhiHour()
+
+		if output != v.output {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.output, output)
+		}
+	}
+
+	// 2020-02-04 17:00(UTC)即北京时间2020-02-05 01:00，四柱均按照北京时间计算
+	for _, timezone := range []string{UTC, Tokyo, "America/New_York"} {
+		c := CreateFromGoTime(time.Date(2020, 2, 4, 17, 0, 0, 0, time.UTC)).Timezone(timezone)
+		output := c.GanZhiYear() + " " + c.GanZhiMonth() + " " + c.GanZhiDay() + " " + c.GanZhiHour()
+
+		if expected := "庚子 戊寅 戊寅 癸丑"; output != expected {
+			t.Fatalf("Timezone %s, expected %s, but got %s\n", timezone, expected, output)
+		}
+	}
+}
+
+func TestCarbon_SetGanZhiYearBoundary(t *testing.T) {
+	Tests := []struct {
+		input    string             // 输入值
+		boundary GanZhiYearBoundary // 输入参数
+		output   string             // 期望输出值
+	}{
+		{"2020-01-24", BeginningOfSpring, "己亥"},
+		{"2020-01-25", BeginningOfSpring, "己亥"},
+		{"2020-01-25", LunarNewYear, "庚子"},
+		{"2021-02-04", BeginningOfSpring, "辛丑"},
+		{"2021-02-04", LunarNewYear, "庚子"},
+	}
+
+	for _, v := range Tests {
+		output := parseInBeijing(v.input).SetGanZhiYearBoundary(v.boundary).GanZhiYear()
+
+		if output != v.output {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.output, output)
+		}
+	}
+
+	if Parse("2020-01-25").SetGanZhiYearBoundary(0).Error == nil || SetGanZhiYearBoundary(3) == nil {
+		t.Fatal("Expected error with an invalid boundary\n")
+	}
+
+	SetGanZhiYearBoundary(LunarNewYear)
+	defer SetGanZhiYearBoundary(BeginningOfSpring)
+	if GetGanZhiYearBoundary() != LunarNewYear || parseInBeijing("2020-01-25").GanZhiYear() != "庚子" || parseInBeijing("2020-01-25").SetGanZhiYearBoundary(BeginningOfSpring).AddDay().GanZhiYear() != "己亥" {
+		t.Fatal("Expected the global ganzhi year boundary to be used\n")
+	}
+}
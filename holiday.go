@@ -0,0 +1,225 @@
+package carbon
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// chineseArrangement 国务院办公厅发布的节假日安排
+type chineseArrangement struct {
+	Name     string   `json:"name"`     // 节假日名称
+	Start    string   `json:"start"`    // 放假开始日期
+	End      string   `json:"end"`      // 放假结束日期
+	Workdays []string `json:"workdays"` // 调休上班日期
+}
+
+var (
+	// 中国法定节假日及调休安排，按年份划分，来源于国务院办公厅每年发布的部分节假日安排通知
+	chineseArrangements = map[int][]chineseArrangement{
+		2020: {
+			{"元旦", "2020-01-01", "2020-01-01", nil},
+			{"春节", "2020-01-24", "2020-02-02", []string{"2020-01-19"}},
+			{"清明节", "2020-04-04", "2020-04-06", nil},
+			{"劳动节", "2020-05-01", "2020-05-05", []string{"2020-04-26", "2020-05-09"}},
+			{"端午节", "2020-06-25", "2020-06-27", []string{"2020-06-28"}},
+			{"国庆节、中秋节", "2020-10-01", "2020-10-08", []string{"2020-09-27", "2020-10-10"}},
+		},
+		2021: {
+			{"元旦", "2021-01-01", "2021-01-03", nil},
+			{"春节", "2021-02-11", "2021-02-17", []string{"2021-02-07", "2021-02-20"}},
+			{"清明节", "2021-04-03", "2021-04-05", nil},
+			{"劳动节", "2021-05-01", "2021-05-05", []string{"2021-04-25", "2021-05-08"}},
+			{"端午节", "2021-06-12", "2021-06-14", nil},
+			{"中秋节", "2021-09-19", "2021-09-21", []string{"2021-09-18"}},
+			{"国庆节", "2021-10-01", "2021-10-07", []string{"2021-09-26", "2021-10-09"}},
+		},
+		2022: {
+			{"元旦", "2022-01-01", "2022-01-03", nil},
+			{"春节", "2022-01-31", "2022-02-06", []string{"2022-01-29", "2022-01-30"}},
+			{"清明节", "2022-04-03", "2022-04-05", []string{"2022-04-02"}},
+			{"劳动节", "2022-04-30", "2022-05-04", []string{"2022-04-24", "2022-05-07"}},
+			{"端午节", "2022-06-03", "2022-06-05", nil},
+			{"中秋节", "2022-09-10", "2022-09-12", nil},
+			{"国庆节", "2022-10-01", "2022-10-07", []string{"2022-10-08", "2022-10-09"}},
+		},
+		2023: {
+			{"元旦", "2022-12-31", "2023-01-02", nil},
+			{"春节", "2023-01-21", "2023-01-27", []string{"2023-01-28", "2023-01-29"}},
+			{"清明节", "2023-04-05", "2023-04-05", nil},
+			{"劳动节", "2023-04-29", "2023-05-03", []string{"2023-04-23", "2023-05-06"}},
+			{"端午节", "2023-06-22", "2023-06-24", []string{"2023-06-25"}},
+			{"国庆节、中秋节", "2023-09-29", "2023-10-06", []string{"2023-10-07", "2023-10-08"}},
+		},
+		2024: {
+			{"元旦", "2024-01-01", "2024-01-01", nil},
+			{"春节", "2024-02-10", "2024-02-17", []string{"2024-02-04", "2024-02-18"}},
+			{"清明节", "2024-04-04", "2024-04-06", []string{"2024-04-07"}},
+			{"劳动节", "2024-05-01", "2024-05-05", []string{"2024-04-28", "2024-05-11"}},
+			{"端午节", "2024-06-10", "2024-06-10", nil},
+			{"中秋节", "2024-09-15", "2024-09-17", []string{"2024-09-14"}},
+			{"国庆节", "2024-10-01", "2024-10-07", []string{"2024-09-29", "2024-10-12"}},
+		},
+		2025: {
+			{"元旦", "2025-01-01", "2025-01-01", nil},
+			{"春节", "2025-01-28", "2025-02-04", []string{"2025-01-26", "2025-02-08"}},
+			{"清明节", "2025-04-04", "2025-04-06", nil},
+			{"劳动节", "2025-05-01", "2025-05-05", []string{"2025-04-27"}},
+			{"端午节", "2025-05-31", "2025-06-02", nil},
+			{"国庆节、中秋节", "2025-10-01", "2025-10-08", []string{"2025-09-28", "2025-10-11"}},
+		},
+		2026: {
+			{"元旦", "2026-01-01", "2026-01-03", []string{"2026-01-04"}},
+			{"春节", "2026-02-15", "2026-02-23", []string{"2026-02-14", "2026-02-28"}},
+			{"清明节", "2026-04-04", "2026-04-06", nil},
+			{"劳动节", "2026-05-01", "2026-05-05", []string{"2026-05-09"}},
+			{"端午节", "2026-06-19", "2026-06-21", nil},
+			{"中秋节", "2026-09-25", "2026-09-27", nil},
+			{"国庆节", "2026-10-01", "2026-10-07", []string{"2026-09-20", "2026-10-10"}},
+		},
+	}
+
+	// 中国法定节假日及调休安排读写锁
+	chineseMutex sync.RWMutex
+)
+
+// LoadChineseHolidays 从JSON文件加载中国法定节假日及调休安排，文件中出现的年份将整体覆盖已有安排
+// 文件格式如 {"2027": [{"name": "元旦", "start": "2027-01-01", "end": "2027-01-03", "workdays": []}]}
+func LoadChineseHolidays(file string) error {
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return loadChineseHolidaysError(file, err)
+	}
+	return loadChineseHolidays(content, file)
+}
+
+// loadChineseHolidays 从JSON内容加载中国法定节假日及调休安排，先校验全部日期再写入
+func loadChineseHolidays(content []byte, file string) error {
+	var data map[string][]chineseArrangement
+	if err := json.Unmarshal(content, &data); err != nil {
+		return loadChineseHolidaysError(file, err)
+	}
+	arrangements := make(map[int][]chineseArrangement, len(data))
+	for key, items := range data {
+		year, err := strconv.Atoi(key)
+		if err != nil {
+			return loadChineseHolidaysError(file, err)
+		}
+		for _, item := range items {
+			if _, _, err := chineseHolidayRange(item); err != nil {
+				return loadChineseHolidaysError(file, err)
+			}
+			for _, date := range item.Workdays {
+				if _, err := businessDateKey(date); err != nil {
+					return loadChineseHolidaysError(file, err)
+				}
+			}
+		}
+		arrangements[year] = items
+	}
+
+	chineseMutex.Lock()
+	defer chineseMutex.Unlock()
+	for year, items := range arrangements {
+		chineseArrangements[year] = items
+	}
+	return nil
+}
+
+// HasChineseHolidays 是否有公历年的中国法定节假日及调休安排，没有安排的年份无法判断节假日及工作日
+func HasChineseHolidays(year int) bool {
+	chineseMutex.RLock()
+	defer chineseMutex.RUnlock()
+	_, ok := chineseArrangements[year]
+	return ok
+}
+
+// HasChineseHolidays 是否有当前实例所在年份的中国法定节假日及调休安排
+func (c Carbon) HasChineseHolidays() bool {
+	return !c.Time.IsZero() && HasChineseHolidays(c.Time.Year())
+}
+
+// ChineseBusinessCalendar 根据中国法定节假日及调休安排创建工作日历(周六、周日休息)，没有安排的年份仅按照周末计算
+func ChineseBusinessCalendar() *BusinessCalendar {
+	bc := NewBusinessCalendar()
+	chineseMutex.RLock()
+	defer chineseMutex.RUnlock()
+	for _, items := range chineseArrangements {
+		for _, item := range items {
+			start, end, _ := chineseHolidayRange(item)
+			for t := start; !t.After(end); t = t.AddDate(0, 0, 1) {
+				bc.AddHoliday(t.Format(DateFormat), item.Name)
+			}
+			for _, date := range item.Workdays {
+				bc.AddWorkday(date, item.Name)
+			}
+		}
+	}
+	return bc
+}
+
+// IsChineseHoliday 是否是中国法定节假日(包括调休放假的日期)，没有安排的年份返回false
+func (c Carbon) IsChineseHoliday() bool {
+	return c.ChineseHolidayName() != ""
+}
+
+// IsChineseWorkday 是否是中国工作日(包括调休上班的周末)，没有安排的年份无法判断，返回false
+func (c Carbon) IsChineseWorkday() bool {
+	if !c.HasChineseHolidays() {
+		return false
+	}
+	if c.chineseWorkdayName() != "" {
+		return true
+	}
+	return !c.IsChineseHoliday() && c.IsWeekday()
+}
+
+// ChineseHolidayName 获取中国法定节假日名称，如春节、国庆节，非节假日或没有安排的年份返回空字符串
+func (c Carbon) ChineseHolidayName() string {
+	if c.Time.IsZero() {
+		return ""
+	}
+	date := c.Time.Format(DateFormat)
+	chineseMutex.RLock()
+	defer chineseMutex.RUnlock()
+	for _, year := range []int{c.Time.Year(), c.Time.Year() + 1} {
+		for _, item := range chineseArrangements[year] {
+			if item.Start <= date && date <= item.End {
+				return item.Name
+			}
+		}
+	}
+	return ""
+}
+
+// chineseWorkdayName 获取调休上班对应的节假日名称，非调休上班日期时返回空字符串
+func (c Carbon) chineseWorkdayName() string {
+	date := c.Time.Format(DateFormat)
+	chineseMutex.RLock()
+	defer chineseMutex.RUnlock()
+	for _, year := range []int{c.Time.Year(), c.Time.Year() + 1} {
+		for _, item := range chineseArrangements[year] {
+			for _, workday := range item.Workdays {
+				if workday == date {
+					return item.Name
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// chineseHolidayRange 解析节假日安排的放假开始及结束日期
+func chineseHolidayRange(item chineseArrangement) (time.Time, time.Time, error) {
+	start, err := parseByLayout(item.Start, DateFormat)
+	if err != nil {
+		return start, start, err
+	}
+	end, err := parseByLayout(item.End, DateFormat)
+	if err != nil {
+		return start, end, err
+	}
+	return start, end, nil
+}
@@ -0,0 +1,165 @@
+package carbon
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestCarbon_IsChineseHoliday(t *testing.T) {
+	Tests := []struct {
+		input       string // 输入值
+		isHoliday   bool   // 期望是否是法定节假日
+		isWorkday   bool   // 期望是否是工作日
+		holidayName string // 期望节假日名称
+	}{
+		{"0000-00-00", false, false, ""},
+		{"2020-01-01", true, false, "元旦"},
+		{"2020-01-19", false, true, ""},
+		{"2020-02-02", true, false, "春节"},
+		{"2020-09-27", false, true, ""},
+		{"2020-09-30 13:14:15", false, true, ""},
+		{"2020-10-01 13:14:15", true, false, "国庆节、中秋节"},
+		{"2020-10-08", true, false, ""},
+		{"2020-10-10", false, true, ""},
+		{"2020-10-11", false, false, ""},
+		{"2020-10-12", false, true, ""},
+		{"2021-02-20", false, true, ""},
+		{"2022-12-31", true, false, "元旦"},
+		{"2023-01-02", true, false, "元旦"},
+		{"2023-01-28", false, true, ""},
+		{"2026-01-03", true, false, "元旦"},
+		{"2026-01-04", false, true, ""},
+		{"2026-02-14", false, true, ""},
+		{"2026-02-23", true, false, "春节"},
+		{"2026-06-19", true, false, "端午节"},
+		{"2026-09-20", false, true, ""},
+		{"2026-10-07", true, false, "国庆节"},
+		{"2026-10-10", false, true, ""},
+		{"2019-10-08", false, false, ""},
+		{"2030-01-02", false, false, ""},
+	}
+
+	for _, v := range Tests {
+		c := Parse(v.input)
+
+		if c.IsChineseHoliday() != v.isHoliday {
+			t.Fatalf("Input %s, expected %t, but got %t\n", v.input, v.isHoliday, c.IsChineseHoliday())
+		}
+
+		if c.IsChineseWorkday() != v.isWorkday {
+			t.Fatalf("Input %s, expected %t, but got %t\n", v.input, v.isWorkday, c.IsChineseWorkday())
+		}
+
+		if v.holidayName != "" && c.ChineseHolidayName() != v.holidayName {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.holidayName, c.ChineseHolidayName())
+		}
+	}
+
+	// 没有安排的年份无法判断节假日及工作日
+	if !HasChineseHolidays(2026) || HasChineseHolidays(2019) || HasChineseHolidays(2030) || !Parse("2026-12-31").HasChineseHolidays() || Parse("2030-01-02").HasChineseHolidays() || Parse("").HasChineseHolidays() {
+		t.Fatal("Expected the years of chinese holidays to be checked\n")
+	}
+}
+
+func TestCarbon_ChineseBusinessCalendar(t *testing.T) {
+	bc := ChineseBusinessCalendar()
+	Tests := []struct {
+		input  string // 输入值
+		output string // 期望输出值
+	}{
+		{"2020-09-30 13:14:15", "2020-10-09 13:14:15"},
+		{"2020-10-09 13:14:15", "2020-10-10 13:14:15"},
+		{"2021-02-10 13:14:15", "2021-02-18 13:14:15"},
+	}
+
+	for _, v := range Tests {
+		output := Parse(v.input).SetBusinessCalendar(bc).NextBusinessDay().ToDateTimeString()
+
+		if output != v.output {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.output, output)
+		}
+	}
+
+	if Parse("2020-10-01").SetBusinessCalendar(bc).HolidayName() != "国庆节、中秋节" || Parse("2020-10-10").SetBusinessCalendar(bc).HolidayName() != "" {
+		t.Fatal("Expected the chinese holiday name to be used\n")
+	}
+}
+
+func TestCarbon_HolidayName(t *testing.T) {
+	// 工作日历中没有该日期时使用中国法定节假日名称
+	bc := NewBusinessCalendar()
+	bc.AddHoliday("2020-10-01", "National Day")
+	Tests := []struct {
+		input  Carbon // 输入值
+		output string // 期望输出值
+	}{
+		{Parse("2020-10-01"), "国庆节、中秋节"},
+		{Parse("2021-02-12"), "春节"},
+		{Parse("2020-10-10"), ""},
+		{Parse("2020-10-01").SetBusinessCalendar(bc), "National Day"},
+		{Parse("2021-02-12").SetBusinessCalendar(bc), "春节"},
+		{Parse(""), ""},
+	}
+
+	for _, v := range Tests {
+		if output := v.input.HolidayName(); output != v.output {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input.ToDateString(), v.output, output)
+		}
+	}
+}
+
+func TestLoadChineseHolidays(t *testing.T) {
+	file, err := ioutil.TempFile("", "holidays-*.json")
+	if err != nil {
+		t.Fatalf("Unexpected error %v\n", err)
+	}
+	defer os.Remove(file.Name())
+	file.WriteString(`{"2021": [{"name": "元旦", "start": "2021-01-01", "end": "2021-01-01"}], "2099": [{"name": "元旦", "start": "2099-01-01", "end": "2099-01-03", "workdays": ["2099-01-04"]}]}`)
+	file.Close()
+
+	chineseMutex.Lock()
+	origin := chineseArrangements[2021]
+	chineseMutex.Unlock()
+	defer func() {
+		chineseMutex.Lock()
+		chineseArrangements[2021] = origin
+		delete(chineseArrangements, 2099)
+		chineseMutex.Unlock()
+	}()
+
+	if err := LoadChineseHolidays(file.Name()); err != nil {
+		t.Fatalf("Unexpected error %v\n", err)
+	}
+
+	Tests := []struct {
+		input  string // 输入值
+		output bool   // 期望输出值
+	}{
+		{"2021-01-01", true},
+		{"2021-01-02", false},
+		{"2021-02-11", false},
+		{"2099-01-03", true},
+		{"2099-01-04", false},
+	}
+
+	for _, v := range Tests {
+		output := Parse(v.input).IsChineseHoliday()
+
+		if output != v.output {
+			t.Fatalf("Input %s, expected %t, but got %t\n", v.input, v.output, output)
+		}
+	}
+
+	if !Parse("2099-01-04").IsChineseWorkday() {
+		t.Fatal("Expected the adjusted workday to be loaded\n")
+	}
+
+	if LoadChineseHolidays("xxx.json") == nil {
+		t.Fatal("Expected error with a missing file\n")
+	}
+
+	if loadChineseHolidays([]byte(`xxx`), "xxx.json") == nil || loadChineseHolidays([]byte(`{"xxx": []}`), "xxx.json") == nil || loadChineseHolidays([]byte(`{"2099": [{"name": "xxx", "start": "2099-13-01", "end": "2099-13-01"}]}`), "xxx.json") == nil || loadChineseHolidays([]byte(`{"2099": [{"name": "xxx", "start": "2099-01-01", "end": "2099-01-01", "workdays": ["xxx"]}]}`), "xxx.json") == nil {
+		t.Fatal("Expected error with an invalid file\n")
+	}
+}
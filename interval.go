@@ -0,0 +1,209 @@
+package carbon
+
+import (
+	"math/big"
+	"sort"
+	"time"
+)
+
+// Interval 时间区间，包括开始时间，不包括结束时间
+type Interval struct {
+	start Carbon
+	end   Carbon
+	Error error
+}
+
+// IntervalSet 时间区间集合，区间按开始时间排序且互不重叠
+type IntervalSet struct {
+	intervals []Interval
+}
+
+// NewInterval 创建时间区间，结束时间早于开始时间时返回错误
+func NewInterval(start Carbon, end Carbon) Interval {
+	i := Interval{start: start, end: end}
+	switch {
+	case start.Error != nil:
+		i.Error = start.Error
+	case end.Error != nil:
+		i.Error = end.Error
+	case end.Time.Before(start.Time):
+		i.Error = invalidIntervalError(start, end)
+	}
+	return i
+}
+
+// Start 获取开始时间
+func (i Interval) Start() Carbon {
+	return i.start
+}
+
+// End 获取结束时间
+func (i Interval) End() Carbon {
+	return i.end
+}
+
+// Duration 获取时长
+func (i Interval) Duration() time.Duration {
+	if i.Error != nil {
+		return 0
+	}
+	return i.end.Time.Sub(i.start.Time)
+}
+
+// IsEmpty 是否是空区间(开始时间等于结束时间)
+func (i Interval) IsEmpty() bool {
+	return i.Error == nil && i.start.Time.Equal(i.end.Time)
+}
+
+// Contains 是否包含指定时间
+func (i Interval) Contains(c Carbon) bool {
+	if i.Error != nil || c.Error != nil {
+		return false
+	}
+	return !c.Time.Before(i.start.Time) && c.Time.Before(i.end.Time)
+}
+
+// Overlaps 是否与另一个区间重叠
+func (i Interval) Overlaps(o Interval) bool {
+	if i.Error != nil || o.Error != nil {
+		return false
+	}
+	return i.start.Time.Before(o.end.Time) && o.start.Time.Before(i.end.Time)
+}
+
+// Abuts 是否与另一个区间首尾相接
+func (i Interval) Abuts(o Interval) bool {
+	if i.Error != nil || o.Error != nil {
+		return false
+	}
+	return i.end.Time.Equal(o.start.Time) || o.end.Time.Equal(i.start.Time)
+}
+
+// Intersect 获取与另一个区间的交集，不重叠时返回false
+func (i Interval) Intersect(o Interval) (Interval, bool) {
+	if !i.Overlaps(o) {
+		return Interval{}, false
+	}
+	return Interval{start: Max(i.start, o.start), end: Min(i.end, o.end)}, true
+}
+
+// Union 获取与另一个区间的并集，既不重叠也不相接时返回false
+func (i Interval) Union(o Interval) (Interval, bool) {
+	if !i.Overlaps(o) && !i.Abuts(o) {
+		return Interval{}, false
+	}
+	return Interval{start: Min(i.start, o.start), end: Max(i.end, o.end)}, true
+}
+
+// Gap 获取与另一个区间之间的间隔，重叠或相接时返回false
+func (i Interval) Gap(o Interval) (Interval, bool) {
+	if i.Error != nil || o.Error != nil || i.Overlaps(o) || i.Abuts(o) {
+		return Interval{}, false
+	}
+	if i.end.Time.Before(o.start.Time) {
+		return Interval{start: i.end, end: o.start}, true
+	}
+	return Interval{start: o.end, end: i.start}, true
+}
+
+// Split 将区间平均拆分为n个区间，n小于1时返回nil
+func (i Interval) Split(n int) []Interval {
+	if i.Error != nil || n < 1 {
+		return nil
+	}
+	intervals := make([]Interval, n)
+	start := i.start
+	for k := 1; k <= n; k++ {
+		end := i.end
+		if k < n {
+			end.Time = i.splitAt(k, n)
+		}
+		intervals[k-1] = Interval{start: start, end: end}
+		start = end
+	}
+	return intervals
+}
+
+// splitAt 获取区间第k/n处的时间，按照秒及纳秒计算，避免时长超出time.Duration范围(约292年)时饱和
+func (i Interval) splitAt(k int, n int) time.Time {
+	seconds := big.NewInt(i.end.Time.Unix() - i.start.Time.Unix())
+	nanoseconds := big.NewInt(int64(i.end.Time.Nanosecond() - i.start.Time.Nanosecond()))
+	offset := seconds.Mul(seconds, big.NewInt(int64(time.Second))).Add(seconds, nanoseconds)
+	offset.Mul(offset, big.NewInt(int64(k))).Quo(offset, big.NewInt(int64(n)))
+	second, nanosecond := offset.QuoRem(offset, big.NewInt(int64(time.Second)), new(big.Int))
+	return time.Unix(i.start.Time.Unix()+second.Int64(), int64(i.start.Time.Nanosecond())+nanosecond.Int64()).In(i.start.Time.Location())
+}
+
+// NewIntervalSet 创建时间区间集合，重叠或相接的区间将被合并，空区间及错误区间将被忽略
+func NewIntervalSet(intervals ...Interval) IntervalSet {
+	s := IntervalSet{}
+	for _, i := range intervals {
+		s = s.Add(i)
+	}
+	return s
+}
+
+// Add 添加区间，与已有区间重叠或相接时合并
+func (s IntervalSet) Add(i Interval) IntervalSet {
+	if i.Error != nil || i.IsEmpty() {
+		return s
+	}
+	intervals := make([]Interval, 0, len(s.intervals)+1)
+	for _, o := range s.intervals {
+		if u, ok := i.Union(o); ok {
+			i = u
+			continue
+		}
+		intervals = append(intervals, o)
+	}
+	intervals = append(intervals, i)
+	sort.Slice(intervals, func(a, b int) bool {
+		return intervals[a].start.Time.Before(intervals[b].start.Time)
+	})
+	return IntervalSet{intervals: intervals}
+}
+
+// Subtract 减去区间，如从可用时段中去除已预订时段
+func (s IntervalSet) Subtract(i Interval) IntervalSet {
+	if i.Error != nil || i.IsEmpty() {
+		return s
+	}
+	intervals := make([]Interval, 0, len(s.intervals)+1)
+	for _, o := range s.intervals {
+		if !o.Overlaps(i) {
+			intervals = append(intervals, o)
+			continue
+		}
+		if o.start.Time.Before(i.start.Time) {
+			intervals = append(intervals, Interval{start: o.start, end: i.start})
+		}
+		if i.end.Time.Before(o.end.Time) {
+			intervals = append(intervals, Interval{start: i.end, end: o.end})
+		}
+	}
+	return IntervalSet{intervals: intervals}
+}
+
+// Intervals 获取集合内的区间
+func (s IntervalSet) Intervals() []Interval {
+	return append([]Interval(nil), s.intervals...)
+}
+
+// Contains 是否包含指定时间
+func (s IntervalSet) Contains(c Carbon) bool {
+	for _, i := range s.intervals {
+		if i.Contains(c) {
+			return true
+		}
+	}
+	return false
+}
+
+// Duration 获取集合内区间的总时长
+func (s IntervalSet) Duration() time.Duration {
+	var d time.Duration
+	for _, i := range s.intervals {
+		d += i.Duration()
+	}
+	return d
+}
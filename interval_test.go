@@ -0,0 +1,201 @@
+package carbon
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestInterval 通过时间字符串创建时间区间
+func newTestInterval(start string, end string) Interval {
+	return NewInterval(Parse(start), Parse(end))
+}
+
+// intervalString 输出时间区间字符串，用于比较
+func intervalString(i Interval, ok bool) string {
+	if !ok {
+		return ""
+	}
+	return i.Start().ToTimeString() + "~" + i.End().ToTimeString()
+}
+
+func TestInterval_Contains(t *testing.T) {
+	i := newTestInterval("2020-08-05 09:00:00", "2020-08-05 12:00:00")
+	Tests := []struct {
+		input  string // 输入值
+		output bool   // 期望输出值
+	}{
+		{"2020-08-05 08:59:59", false},
+		{"2020-08-05 09:00:00", true},
+		{"2020-08-05 11:59:59", true},
+		{"2020-08-05 12:00:00", false},
+		{"xxx", false},
+	}
+
+	for _, v := range Tests {
+		output := i.Contains(Parse(v.input))
+
+		if output != v.output {
+			t.Fatalf("Input %s, expected %t, but got %t\n", v.input, v.output, output)
+		}
+	}
+
+	if i.Duration() != 3*time.Hour || i.IsEmpty() || !newTestInterval("2020-08-05", "2020-08-05").IsEmpty() {
+		t.Fatal("Expected the duration to be 3 hours\n")
+	}
+}
+
+func TestInterval_Algebra(t *testing.T) {
+	i := newTestInterval("2020-08-05 09:00:00", "2020-08-05 12:00:00")
+	Tests := []struct {
+		input     Interval // 输入值
+		overlaps  bool     // 期望是否重叠
+		abuts     bool     // 期望是否相接
+		intersect string   // 期望交集
+		union     string   // 期望并集
+		gap       string   // 期望间隔
+	}{
+		{newTestInterval("2020-08-05 10:00:00", "2020-08-05 13:00:00"), true, false, "10:00:00~12:00:00", "09:00:00~13:00:00", ""},
+		{newTestInterval("2020-08-05 10:00:00", "2020-08-05 11:00:00"), true, false, "10:00:00~11:00:00", "09:00:00~12:00:00", ""},
+		{newTestInterval("2020-08-05 12:00:00", "2020-08-05 13:00:00"), false, true, "", "09:00:00~13:00:00", ""},
+		{newTestInterval("2020-08-05 07:00:00", "2020-08-05 09:00:00"), false, true, "", "07:00:00~12:00:00", ""},
+		{newTestInterval("2020-08-05 14:00:00", "2020-08-05 15:00:00"), false, false, "", "", "12:00:00~14:00:00"},
+		{newTestInterval("2020-08-05 06:00:00", "2020-08-05 08:00:00"), false, false, "", "", "08:00:00~09:00:00"},
+		{newTestInterval("2020-08-05 15:00:00", "2020-08-05 14:00:00"), false, false, "", "", ""},
+	}
+
+	for _, v := range Tests {
+		if i.Overlaps(v.input) != v.overlaps || v.input.Overlaps(i) != v.overlaps {
+			t.Fatalf("Input %s, expected %t, but got %t\n", intervalString(v.input, true), v.overlaps, i.Overlaps(v.input))
+		}
+
+		if i.Abuts(v.input) != v.abuts {
+			t.Fatalf("Input %s, expected %t, but got %t\n", intervalString(v.input, true), v.abuts, i.Abuts(v.input))
+		}
+
+		if output := intervalString(i.Intersect(v.input)); output != v.intersect {
+			t.Fatalf("Input %s, expected %s, but got %s\n", intervalString(v.input, true), v.intersect, output)
+		}
+
+		if output := intervalString(i.Union(v.input)); output != v.union {
+			t.Fatalf("Input %s, expected %s, but got %s\n", intervalString(v.input, true), v.union, output)
+		}
+
+		if output := intervalString(i.Gap(v.input)); output != v.gap {
+			t.Fatalf("Input %s, expected %s, but got %s\n", intervalString(v.input, true), v.gap, output)
+		}
+	}
+}
+
+func TestInterval_Split(t *testing.T) {
+	Tests := []struct {
+		input  Interval // 输入值
+		n      int      // 输入参数
+		output string   // 期望输出值
+	}{
+		{newTestInterval("2020-08-05 09:00:00", "2020-08-05 12:00:00"), 3, "09:00:00~10:00:00,10:00:00~11:00:00,11:00:00~12:00:00"},
+		{newTestInterval("2020-08-05 09:00:00", "2020-08-05 09:00:10"), 3, "09:00:00~09:00:03,09:00:03~09:00:06,09:00:06~09:00:10"},
+		{newTestInterval("2020-08-05 09:00:00", "2020-08-05 12:00:00"), 1, "09:00:00~12:00:00"},
+		{newTestInterval("2020-08-05 09:00:00", "2020-08-05 12:00:00"), 0, ""},
+		{newTestInterval("2020-08-05 12:00:00", "2020-08-05 09:00:00"), 2, ""},
+	}
+
+	for _, v := range Tests {
+		parts := make([]string, 0)
+		for _, i := range v.input.Split(v.n) {
+			parts = append(parts, intervalString(i, true))
+		}
+		output := strings.Join(parts, ",")
+
+		if output != v.output {
+			t.Fatalf("Input %s, expected %s, but got %s\n", intervalString(v.input, true), v.output, output)
+		}
+	}
+}
+
+func TestInterval_SplitLongInterval(t *testing.T) {
+	i := newTestInterval("2000-01-01 00:00:00", "2010-01-01 00:00:00")
+	for _, n := range []int{40, 1000, 100000} {
+		parts := i.Split(n)
+		if len(parts) != n || !parts[0].Start().Eq(i.Start()) || !parts[n-1].End().Eq(i.End()) {
+			t.Fatalf("Input %d, expected %d parts from %s to %s\n", n, n, i.Start().ToDateTimeString(), i.End().ToDateTimeString())
+		}
+		for k, part := range parts {
+			if part.IsEmpty() || !part.End().Gt(part.Start()) || (k > 0 && !part.Start().Eq(parts[k-1].End())) {
+				t.Fatalf("Input %d, expected part %d in order, but got %s~%s\n", n, k, part.Start().ToDateTimeString(), part.End().ToDateTimeString())
+			}
+		}
+	}
+}
+
+func TestInterval_SplitOverDurationRange(t *testing.T) {
+	i := NewInterval(Timezone(UTC).CreateFromGoTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)), Timezone(UTC).CreateFromGoTime(time.Date(2420, 1, 1, 0, 0, 0, 0, time.UTC)))
+	Tests := []struct {
+		n      int    // 输入参数
+		output string // 期望输出值
+	}{
+		{2, "2220-01-01 12:00:00"},
+		{4, "2120-01-01 06:00:00"},
+	}
+
+	for _, v := range Tests {
+		parts := i.Split(v.n)
+		if output := parts[0].End().ToDateTimeString(); len(parts) != v.n || output != v.output || !parts[v.n-1].End().Eq(i.End()) {
+			t.Fatalf("Input %d, expected %s, but got %s\n", v.n, v.output, output)
+		}
+	}
+}
+
+func TestInterval_Error(t *testing.T) {
+	Tests := []Interval{
+		NewInterval(Parse("xxx"), Parse("2020-08-05")),
+		NewInterval(Parse("2020-08-05"), Parse("xxx")),
+		NewInterval(Parse("2020-08-06"), Parse("2020-08-05")),
+	}
+
+	for k, i := range Tests {
+		if i.Error == nil || i.Duration() != 0 || i.IsEmpty() || i.Contains(Parse("2020-08-05")) {
+			t.Fatalf("Input %d, expected error, but got nil\n", k)
+		}
+	}
+}
+
+func TestIntervalSet(t *testing.T) {
+	s := NewIntervalSet(
+		newTestInterval("2020-08-05 13:00:00", "2020-08-05 15:00:00"),
+		newTestInterval("2020-08-05 09:00:00", "2020-08-05 11:00:00"),
+		newTestInterval("2020-08-05 10:00:00", "2020-08-05 12:00:00"),
+		newTestInterval("2020-08-05 15:00:00", "2020-08-05 16:00:00"),
+		newTestInterval("2020-08-05 17:00:00", "2020-08-05 17:00:00"),
+		newTestInterval("2020-08-05 18:00:00", "2020-08-05 17:00:00"),
+	)
+
+	Tests := []struct {
+		input  IntervalSet // 输入值
+		output string      // 期望输出值
+	}{
+		{s, "09:00:00~12:00:00,13:00:00~16:00:00"},
+		{s.Subtract(newTestInterval("2020-08-05 10:00:00", "2020-08-05 14:00:00")), "09:00:00~10:00:00,14:00:00~16:00:00"},
+		{s.Subtract(newTestInterval("2020-08-05 08:00:00", "2020-08-05 12:00:00")), "13:00:00~16:00:00"},
+		{s.Subtract(newTestInterval("2020-08-05 13:30:00", "2020-08-05 14:00:00")), "09:00:00~12:00:00,13:00:00~13:30:00,14:00:00~16:00:00"},
+		{s.Subtract(newTestInterval("2020-08-05 12:00:00", "2020-08-05 13:00:00")), "09:00:00~12:00:00,13:00:00~16:00:00"},
+		{s.Add(newTestInterval("2020-08-05 12:00:00", "2020-08-05 13:00:00")), "09:00:00~16:00:00"},
+		{NewIntervalSet(), ""},
+	}
+
+	for _, v := range Tests {
+		parts := make([]string, 0)
+		for _, i := range v.input.Intervals() {
+			parts = append(parts, intervalString(i, true))
+		}
+		output := strings.Join(parts, ",")
+
+		if output != v.output {
+			t.Fatalf("Expected %s, but got %s\n", v.output, output)
+		}
+	}
+
+	if s.Duration() != 6*time.Hour || !s.Contains(Parse("2020-08-05 15:30:00")) || s.Contains(Parse("2020-08-05 12:30:00")) {
+		t.Fatal("Expected the interval set to be normalized\n")
+	}
+}
@@ -0,0 +1,177 @@
+package carbon
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+)
+
+// 区域常量
+const (
+	English           = "en"    // 英语
+	SimplifiedChinese = "zh-CN" // 简体中文
+
+	DefaultLocale = English // 默认区域
+)
+
+var (
+	// 内置语言包，单复数形式及列表项用|分隔
+	locales = map[string]map[string]string{
+		English: {
+			"months":         "January|February|March|April|May|June|July|August|September|October|November|December",
+			"short_months":   "Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec",
+			"weeks":          "Sunday|Monday|Tuesday|Wednesday|Thursday|Friday|Saturday",
+			"short_weeks":    "Sun|Mon|Tue|Wed|Thu|Fri|Sat",
+			"meridiem":       "AM|PM",
+			"constellations": "Aries|Taurus|Gemini|Cancer|Leo|Virgo|Libra|Scorpio|Sagittarius|Capricorn|Aquarius|Pisces",
+			"year":           "1 year|%d years",
+			"month":          "1 month|%d months",
+			"week":           "1 week|%d weeks",
+			"day":            "1 day|%d days",
+			"hour":           "1 hour|%d hours",
+			"minute":         "1 minute|%d minutes",
+			"second":         "1 second|%d seconds",
+			"now":            "just now",
+			"ago":            "%s ago",
+			"from_now":       "%s from now",
+			"before":         "%s before",
+			"after":          "%s after",
+		},
+		SimplifiedChinese: {
+			"months":         "一月|二月|三月|四月|五月|六月|七月|八月|九月|十月|十一月|十二月",
+			"short_months":   "1月|2月|3月|4月|5月|6月|7月|8月|9月|10月|11月|12月",
+			"weeks":          "星期日|星期一|星期二|星期三|星期四|星期五|星期六",
+			"short_weeks":    "周日|周一|周二|周三|周四|周五|周六",
+			"meridiem":       "上午|下午",
+			"constellations": "白羊座|金牛座|双子座|巨蟹座|狮子座|处女座|天秤座|天蝎座|射手座|摩羯座|水瓶座|双鱼座",
+			"year":           "%d年",
+			"month":          "%d个月",
+			"week":           "%d周",
+			"day":            "%d天",
+			"hour":           "%d小时",
+			"minute":         "%d分钟",
+			"second":         "%d秒",
+			"now":            "刚刚",
+			"ago":            "%s前",
+			"from_now":       "%s后",
+			"before":         "%s前",
+			"after":          "%s后",
+		},
+	}
+
+	// 全局区域
+	globalLocale = DefaultLocale
+
+	// 语言包读写锁
+	localeMutex sync.RWMutex
+)
+
+// SetLocale 设置全局区域
+func SetLocale(locale string) error {
+	localeMutex.Lock()
+	defer localeMutex.Unlock()
+	if _, ok := locales[locale]; !ok {
+		return invalidLocaleError(locale)
+	}
+	globalLocale = locale
+	return nil
+}
+
+// GetLocale 获取全局区域
+func GetLocale() string {
+	localeMutex.RLock()
+	defer localeMutex.RUnlock()
+	return globalLocale
+}
+
+// LoadLocale 从JSON语言包文件加载区域，已存在的区域将被覆盖同名键名，新区域缺失的键名回退至默认区域
+// 语言包格式参考lang目录下的文件
+func LoadLocale(locale string, file string) error {
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return loadLocaleError(file, err)
+	}
+	resources := make(map[string]string)
+	if err := json.Unmarshal(content, &resources); err != nil {
+		return loadLocaleError(file, err)
+	}
+
+	localeMutex.Lock()
+	defer localeMutex.Unlock()
+	merged := make(map[string]string, len(resources))
+	for key, value := range locales[locale] {
+		merged[key] = value
+	}
+	for key, value := range resources {
+		merged[key] = value
+	}
+	locales[locale] = merged
+	return nil
+}
+
+// Locale 设置区域(仅对当前实例有效)
+func (c Carbon) Locale(locale string) Carbon {
+	if c.Error != nil {
+		return c
+	}
+	localeMutex.RLock()
+	_, ok := locales[locale]
+	localeMutex.RUnlock()
+	if !ok {
+		return c.withError(invalidLocaleError(locale))
+	}
+	c.locale = locale
+	return c
+}
+
+// GetLocale 获取当前实例区域，未设置时返回全局区域
+func (c Carbon) GetLocale() string {
+	if c.locale == "" {
+		return GetLocale()
+	}
+	return c.locale
+}
+
+// translate 翻译指定键名，语言包中不存在时回退至默认区域
+func (c Carbon) translate(key string) string {
+	localeMutex.RLock()
+	defer localeMutex.RUnlock()
+	locale := c.locale
+	if locale == "" {
+		locale = globalLocale
+	}
+	if value, ok := locales[locale][key]; ok {
+		return value
+	}
+	return locales[DefaultLocale][key]
+}
+
+// translateNumber 翻译带数量的键名，根据数量选择单复数形式
+func (c Carbon) translateNumber(key string, number int64) string {
+	forms := strings.Split(c.translate(key), "|")
+	form := forms[len(forms)-1]
+	if number == 1 {
+		form = forms[0]
+	}
+	if strings.Contains(form, "%d") {
+		return fmt.Sprintf(form, number)
+	}
+	return form
+}
+
+// translateDecimal 翻译带数量的键名，数量为小数，如1.5 seconds，使用复数形式
+func (c Carbon) translateDecimal(key string, number string) string {
+	forms := strings.Split(c.translate(key), "|")
+	return strings.Replace(forms[len(forms)-1], "%d", number, 1)
+}
+
+// translateItem 翻译列表类键名中指定序号的项
+func (c Carbon) translateItem(key string, index int) string {
+	items := strings.Split(c.translate(key), "|")
+	if index < 0 || index >= len(items) {
+		return ""
+	}
+	return items[index]
+}
@@ -0,0 +1,104 @@
+package carbon
+
+import "testing"
+
+func TestCarbon_SetLocale(t *testing.T) {
+	defer SetLocale(DefaultLocale)
+
+	Tests := []struct {
+		input  string // 输入值
+		output string // 期望输出值
+	}{
+		{English, English},
+		{SimplifiedChinese, SimplifiedChinese},
+		{"xx", SimplifiedChinese}, // 异常情况
+	}
+
+	for _, v := range Tests {
+		err := SetLocale(v.input)
+
+		if output := GetLocale(); output != v.output {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.output, output)
+		}
+
+		if (v.input == v.output) != (err == nil) {
+			t.Fatalf("Input %s, unexpected error %v\n", v.input, err)
+		}
+	}
+
+	if output := Parse("2020-08-05 13:14:15").DiffForHumans(Parse("2020-08-08 13:14:15")); output != "3天前" {
+		t.Fatalf("Expected %s, but got %s\n", "3天前", output)
+	}
+
+	if output := Parse("2020-08-05 13:14:15").Locale(English).DiffForHumans(Parse("2020-08-08 13:14:15")); output != "3 days before" {
+		t.Fatalf("Expected %s, but got %s\n", "3 days before", output)
+	}
+}
+
+func TestCarbon_Locale(t *testing.T) {
+	Tests := []struct {
+		input  string // 输入值
+		output string // 期望输出值
+	}{
+		{English, English},
+		{SimplifiedChinese, SimplifiedChinese},
+		{"xx", ""}, // 异常情况
+	}
+
+	for _, v := range Tests {
+		c := Parse("2020-08-05 13:14:15").Locale(v.input)
+
+		if v.output == "" {
+			if c.Error == nil {
+				t.Fatalf("Input %s, expected error, but got nil\n", v.input)
+			}
+			continue
+		}
+
+		if output := c.GetLocale(); output != v.output {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.output, output)
+		}
+
+		if output := c.AddDays(3).Timezone(PRC).Now().GetLocale(); output != v.output {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.output, output)
+		}
+	}
+}
+
+func TestCarbon_LoadLocale(t *testing.T) {
+	Tests := []struct {
+		locale string // 输入参数
+		file   string // 输入参数
+		format string // 输入参数
+		output string // 期望输出值
+	}{
+		{"ja", "lang/ja.json", "Y年n月j日 l P", "2020年8月5日 水曜日 午後"},
+		{"zh-TW", "lang/zh-TW.json", "Y年F j日 D", "2020年八月 5日 週三"},
+		{"xx", "lang/xx.json", "", ""}, // 异常情况
+	}
+
+	for _, v := range Tests {
+		err := LoadLocale(v.locale, v.file)
+
+		if v.output == "" {
+			if err == nil {
+				t.Fatalf("Input %s, expected error, but got nil\n", v.file)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Input %s, unexpected error %s\n", v.file, err)
+		}
+
+		output := Parse("2020-08-05 13:14:15").Locale(v.locale).ToFormatString(v.format)
+
+		if output != v.output {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.file, v.output, output)
+		}
+	}
+
+	if output := Parse("2020-08-05 13:14:15").Locale("ja").DiffForHumans(Parse("2020-08-08 13:14:15")); output != "3日前" {
+		t.Fatalf("Expected %s, but got %s\n", "3日前", output)
+	}
+}
@@ -0,0 +1,274 @@
+package carbon
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	minLunarYear = 1900 // 支持的最小农历年
+	maxLunarYear = 2100 // 支持的最大农历年
+)
+
+var (
+	// 农历数据表(1900-2100)
+	// 0-3位表示闰月月份(0表示无闰月)，4-15位依次表示正月至腊月是否为大月(1为30天，0为29天)，16位表示闰月是否为大月
+	lunarTable = [...]int{
+		0x04bd8, 0x04ae0, 0x0a570, 0x054d5, 0x0d260, 0x0d950, 0x16554, 0x056a0, 0x09ad0, 0x055d2, // 1900-1909
+		0x04ae0, 0x0a5b6, 0x0a4d0, 0x0d250, 0x1d255, 0x0b540, 0x0d6a0, 0x0ada2, 0x095b0, 0x14977, // 1910-1919
+		0x04970, 0x0a4b0, 0x0b4b5, 0x06a50, 0x06d40, 0x1ab54, 0x02b60, 0x09570, 0x052f2, 0x04970, // 1920-1929
+		0x06566, 0x0d4a0, 0x0ea50, 0x16a95, 0x05ad0, 0x02b60, 0x186e3, 0x092e0, 0x1c8d7, 0x0c950, // 1930-1939
+		0x0d4a0, 0x1d8a6, 0x0b550, 0x056a0, 0x1a5b4, 0x025d0, 0x092d0, 0x0d2b2, 0x0a950, 0x0b557, // 1940-1949
+		0x06ca0, 0x0b550, 0x15355, 0x04da0, 0x0a5b0, 0x14573, 0x052b0, 0x0a9a8, 0x0e950, 0x06aa0, // 1950-1959
+		0x0aea6, 0x0ab50, 0x04b60, 0x0aae4, 0x0a570, 0x05260, 0x0f263, 0x0d950, 0x05b57, 0x056a0, // 1960-1969
+		0x096d0, 0x04dd5, 0x04ad0, 0x0a4d0, 0x0d4d4, 0x0d250, 0x0d558, 0x0b540, 0x0b6a0, 0x195a6, // 1970-1979
+		0x095b0, 0x049b0, 0x0a974, 0x0a4b0, 0x0b27a, 0x06a50, 0x06d40, 0x0af46, 0x0ab60, 0x09570, // 1980-1989
+		0x04af5, 0x04970, 0x064b0, 0x074a3, 0x0ea50, 0x06b58, 0x05ac0, 0x0ab60, 0x096d5, 0x092e0, // 1990-1999
+		0x0c960, 0x0d954, 0x0d4a0, 0x0da50, 0x07552, 0x056a0, 0x0abb7, 0x025d0, 0x092d0, 0x0cab5, // 2000-2009
+		0x0a950, 0x0b4a0, 0x0baa4, 0x0ad50, 0x055d9, 0x04ba0, 0x0a5b0, 0x15176, 0x052b0, 0x0a930, // 2010-2019
+		0x07954, 0x06aa0, 0x0ad50, 0x05b52, 0x04b60, 0x0a6e6, 0x0a4e0, 0x0d260, 0x0ea65, 0x0d530, // 2020-2029
+		0x05aa0, 0x076a3, 0x096d0, 0x04afb, 0x04ad0, 0x0a4d0, 0x1d0b6, 0x0d250, 0x0d520, 0x0dd45, // 2030-2039
+		0x0b5a0, 0x056d0, 0x055b2, 0x049b0, 0x0a577, 0x0a4b0, 0x0aa50, 0x1b255, 0x06d20, 0x0ada0, // 2040-2049
+		0x14b63, 0x09370, 0x049f8, 0x04970, 0x064b0, 0x168a6, 0x0ea50, 0x06aa0, 0x1a6c4, 0x0aae0, // 2050-2059
+		0x092e0, 0x0d2e3, 0x0c960, 0x0d557, 0x0d4a0, 0x0da50, 0x05d55, 0x056a0, 0x0a6d0, 0x055d4, // 2060-2069
+		0x052d0, 0x0a9b8, 0x0a950, 0x0b4a0, 0x0b6a6, 0x0ad50, 0x055a0, 0x0aba4, 0x0a5b0, 0x052b0, // 2070-2079
+		0x0b273, 0x06930, 0x07337, 0x06aa0, 0x0ad50, 0x14b55, 0x04b60, 0x0a570, 0x054e4, 0x0d160, // 2080-2089
+		0x0e968, 0x0d520, 0x0daa0, 0x16aa6, 0x056d0, 0x04ae0, 0x0a9d4, 0x0a2d0, 0x0d150, 0x0f252, // 2090-2099
+		0x0d520, // 2100
+	}
+
+	// 农历1900年正月初一对应的公历日期
+	lunarBaseDate = time.Date(1900, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	// 农历数字
+	lunarNumbers = [...]string{"〇", "一", "二", "三", "四", "五", "六", "七", "八", "九"}
+
+	// 农历月份
+	lunarMonths = [...]string{"正", "二", "三", "四", "五", "六", "七", "八", "九", "十", "冬", "腊"}
+
+	// 农历日期前缀
+	lunarDayPrefixes = [...]string{"初", "十", "廿"}
+)
+
+// Lunar 农历
+type Lunar struct {
+	year        int  // 农历年
+	month       int  // 农历月
+	day         int  // 农历日
+	isLeapMonth bool // 是否是闰月
+}
+
+// Lunar 获取农历，超出1900-2100农历年范围时返回零值
+func (c Carbon) Lunar() Lunar {
+	if c.Time.IsZero() {
+		return Lunar{}
+	}
+
+	year, month, day := c.Time.Date()
+	offset := int(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Sub(lunarBaseDate).Hours() / HoursPerDay)
+	if offset < 0 {
+		return Lunar{}
+	}
+
+	l := Lunar{year: minLunarYear}
+	for ; l.year <= maxLunarYear; l.year++ {
+		days := lunarDaysInYear(l.year)
+		if offset < days {
+			break
+		}
+		offset -= days
+	}
+	if l.year > maxLunarYear {
+		return Lunar{}
+	}
+
+	leapMonth := lunarLeapMonth(l.year)
+	for l.month = 1; l.month <= MonthsPerYear; l.month++ {
+		days := lunarDaysInMonth(l.year, l.month)
+		if offset < days {
+			break
+		}
+		offset -= days
+
+		if l.month == leapMonth {
+			days = lunarDaysInLeapMonth(l.year)
+			if offset < days {
+				l.isLeapMonth = true
+				break
+			}
+			offset -= days
+		}
+	}
+	l.day = offset + 1
+	return l
+}
+
+// CreateFromLunar 从农历年月日创建Carbon实例
+func CreateFromLunar(year int, month int, day int, isLeapMonth bool) Carbon {
+	if year < minLunarYear || year > maxLunarYear || month < 1 || month > MonthsPerYear || day < 1 {
+		return Carbon{loc: time.Local, Error: invalidLunarError(year, month, day, isLeapMonth)}
+	}
+
+	leapMonth := lunarLeapMonth(year)
+	if isLeapMonth && month != leapMonth {
+		return Carbon{loc: time.Local, Error: invalidLunarError(year, month, day, isLeapMonth)}
+	}
+
+	days := lunarDaysInMonth(year, month)
+	if isLeapMonth {
+		days = lunarDaysInLeapMonth(year)
+	}
+	if day > days {
+		return Carbon{loc: time.Local, Error: invalidLunarError(year, month, day, isLeapMonth)}
+	}
+
+	offset := day - 1
+	for y := minLunarYear; y < year; y++ {
+		offset += lunarDaysInYear(y)
+	}
+	for m := 1; m < month; m++ {
+		offset += lunarDaysInMonth(year, m)
+		if m == leapMonth {
+			offset += lunarDaysInLeapMonth(year)
+		}
+	}
+	if isLeapMonth {
+		offset += lunarDaysInMonth(year, month)
+	}
+
+	t := lunarBaseDate.AddDate(0, 0, offset)
+	return CreateFromDate(t.Year(), int(t.Month()), t.Day())
+}
+
+// CreateFromLunar 从农历年月日创建Carbon实例(指定时区)
+func (c Carbon) CreateFromLunar(year int, month int, day int, isLeapMonth bool) Carbon {
+	s := CreateFromLunar(year, month, day, isLeapMonth)
+	if s.Error != nil {
+		return c.inLocation(s)
+	}
+	y, m, d := s.Time.Date()
+	return c.CreateFromDate(y, int(m), d)
+}
+
+// Year 获取农历年
+func (l Lunar) Year() int {
+	return l.year
+}
+
+// Month 获取农历月
+func (l Lunar) Month() int {
+	return l.month
+}
+
+// Day 获取农历日
+func (l Lunar) Day() int {
+	return l.day
+}
+
+// IsZero 是否是零值
+func (l Lunar) IsZero() bool {
+	return l.year == 0
+}
+
+// IsLeapMonth 是否是闰月
+func (l Lunar) IsLeapMonth() bool {
+	return l.isLeapMonth
+}
+
+// LeapMonth 获取本年闰月月份，无闰月时返回0
+func (l Lunar) LeapMonth() int {
+	if l.IsZero() {
+		return 0
+	}
+	return lunarLeapMonth(l.year)
+}
+
+// IsLeapYear 是否是闰年(有闰月)
+func (l Lunar) IsLeapYear() bool {
+	return l.LeapMonth() > 0
+}
+
+// ToYearString 输出农历年字符串，如二〇二〇年
+func (l Lunar) ToYearString() string {
+	if l.IsZero() {
+		return ""
+	}
+	var b strings.Builder
+	for _, r := range []byte(strconv.Itoa(l.year)) {
+		b.WriteString(lunarNumbers[r-'0'])
+	}
+	b.WriteString("年")
+	return b.String()
+}
+
+// ToMonthString 输出农历月字符串，如腊月、闰四月
+func (l Lunar) ToMonthString() string {
+	if l.IsZero() {
+		return ""
+	}
+	month := lunarMonths[l.month-1] + "月"
+	if l.isLeapMonth {
+		return "闰" + month
+	}
+	return month
+}
+
+// ToDayString 输出农历日字符串，如初八
+func (l Lunar) ToDayString() string {
+	if l.IsZero() {
+		return ""
+	}
+	switch l.day {
+	case 10:
+		return "初十"
+	case 20:
+		return "二十"
+	case 30:
+		return "三十"
+	}
+	return lunarDayPrefixes[l.day/10] + lunarNumbers[l.day%10]
+}
+
+// ToDateString 输出农历日期字符串，如二〇二〇年腊月初八
+func (l Lunar) ToDateString() string {
+	return l.ToYearString() + l.ToMonthString() + l.ToDayString()
+}
+
+// String 实现Stringer接口
+func (l Lunar) String() string {
+	return l.ToDateString()
+}
+
+// lunarLeapMonth 获取农历年闰月月份，无闰月时返回0
+func lunarLeapMonth(year int) int {
+	return lunarTable[year-minLunarYear] & 0xf
+}
+
+// lunarDaysInLeapMonth 获取农历年闰月天数，无闰月时返回0
+func lunarDaysInLeapMonth(year int) int {
+	if lunarLeapMonth(year) == 0 {
+		return 0
+	}
+	if lunarTable[year-minLunarYear]&0x10000 != 0 {
+		return 30
+	}
+	return 29
+}
+
+// lunarDaysInMonth 获取农历年某月(非闰月)天数
+func lunarDaysInMonth(year int, month int) int {
+	if lunarTable[year-minLunarYear]&(0x10000>>uint(month)) != 0 {
+		return 30
+	}
+	return 29
+}
+
+// lunarDaysInYear 获取农历年总天数
+func lunarDaysInYear(year int) int {
+	days := lunarDaysInLeapMonth(year)
+	for month := 1; month <= MonthsPerYear; month++ {
+		days += lunarDaysInMonth(year, month)
+	}
+	return days
+}
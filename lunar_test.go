@@ -0,0 +1,131 @@
+package carbon
+
+import "testing"
+
+func TestCarbon_Lunar(t *testing.T) {
+	Tests := []struct {
+		input       string // 输入值
+		year        int    // 期望农历年
+		month       int    // 期望农历月
+		day         int    // 期望农历日
+		isLeapMonth bool   // 期望是否是闰月
+	}{
+		{"0000-00-00", 0, 0, 0, false},
+		{"1900-01-30", 0, 0, 0, false},
+		{"1900-01-31", 1900, 1, 1, false},
+		{"2017-08-21", 2017, 6, 30, true},
+		{"2020-01-24", 2019, 12, 30, false},
+		{"2020-05-23", 2020, 4, 1, true},
+		{"2020-08-05", 2020, 6, 16, false},
+		{"2021-01-20", 2020, 12, 8, false},
+		{"2021-02-12", 2021, 1, 1, false},
+		{"2033-12-22", 2033, 11, 1, true},
+		{"2100-12-31", 2100, 12, 1, false},
+		{"2101-01-29", 0, 0, 0, false},
+	}
+
+	for _, v := range Tests {
+		l := Parse(v.input).Lunar()
+
+		if l.Year() != v.year || l.Month() != v.month || l.Day() != v.day || l.IsLeapMonth() != v.isLeapMonth {
+			t.Fatalf("Input %s, expected %d-%d-%d(%t), but got %d-%d-%d(%t)\n", v.input, v.year, v.month, v.day, v.isLeapMonth, l.Year(), l.Month(), l.Day(), l.IsLeapMonth())
+		}
+	}
+}
+
+func TestLunar_ToDateString(t *testing.T) {
+	Tests := []struct {
+		input  string // 输入值
+		output string // 期望输出值
+	}{
+		{"0000-00-00", ""},
+		{"1900-01-31", "一九〇〇年正月初一"},
+		{"2020-01-24", "二〇一九年腊月三十"},
+		{"2020-05-23", "二〇二〇年闰四月初一"},
+		{"2020-08-05", "二〇二〇年六月十六"},
+		{"2020-08-14", "二〇二〇年六月廿五"},
+		{"2020-12-05", "二〇二〇年十月廿一"},
+		{"2021-01-01", "二〇二〇年冬月十八"},
+		{"2021-01-20", "二〇二〇年腊月初八"},
+		{"2021-01-22", "二〇二〇年腊月初十"},
+		{"2021-02-01", "二〇二〇年腊月二十"},
+	}
+
+	for _, v := range Tests {
+		output := Parse(v.input).Lunar().ToDateString()
+
+		if output != v.output {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.output, output)
+		}
+	}
+}
+
+func TestLunar_LeapMonth(t *testing.T) {
+	Tests := []struct {
+		input  string // 输入值
+		output int    // 期望输出值
+	}{
+		{"0000-00-00", 0},
+		{"2020-01-24", 0},
+		{"2020-08-05", 4},
+		{"2021-08-05", 0},
+		{"2023-08-05", 2},
+		{"2034-01-01", 11},
+	}
+
+	for _, v := range Tests {
+		output := Parse(v.input).Lunar().LeapMonth()
+
+		if output != v.output {
+			t.Fatalf("Input %s, expected %d, but got %d", v.input, v.output, output)
+		}
+	}
+}
+
+func TestCarbon_CreateFromLunar(t *testing.T) {
+	Tests := []struct {
+		year        int    // 输入农历年
+		month       int    // 输入农历月
+		day         int    // 输入农历日
+		isLeapMonth bool   // 输入是否是闰月
+		output      string // 期望输出值
+	}{
+		{1900, 1, 1, false, "1900-01-31"},
+		{2017, 6, 30, true, "2017-08-21"},
+		{2019, 12, 30, false, "2020-01-24"},
+		{2020, 4, 1, true, "2020-05-23"},
+		{2020, 6, 16, false, "2020-08-05"},
+		{2020, 12, 8, false, "2021-01-20"},
+		{2033, 11, 1, true, "2033-12-22"},
+		{2100, 12, 29, false, "2101-01-28"},
+
+		{1899, 1, 1, false, ""},  // 异常情况
+		{2101, 1, 1, false, ""},  // 异常情况
+		{2020, 13, 1, false, ""}, // 异常情况
+		{2020, 5, 1, true, ""},   // 异常情况
+		{2020, 4, 30, true, ""},  // 异常情况
+		{2020, 6, 30, false, ""}, // 异常情况
+		{2020, 6, 0, false, ""},  // 异常情况
+	}
+
+	for _, v := range Tests {
+		c := CreateFromLunar(v.year, v.month, v.day, v.isLeapMonth)
+		output := c.ToDateString()
+
+		if output != v.output {
+			t.Fatalf("Input %d-%d-%d(%t), expected %s, but got %s\n", v.year, v.month, v.day, v.isLeapMonth, v.output, output)
+		}
+
+		if (v.output == "") != (c.Error != nil) {
+			t.Fatalf("Input %d-%d-%d(%t), unexpected error %v\n", v.year, v.month, v.day, v.isLeapMonth, c.Error)
+		}
+	}
+
+	for _, v := range Tests {
+		output := Timezone(PRC).CreateFromLunar(v.year, v.month, v.day, v.isLeapMonth).ToDateString()
+
+		if output != v.output {
+			t.Fatalf("Input %d-%d-%d(%t), expected %s, but got %s\n", v.year, v.month, v.day, v.isLeapMonth, v.output, output)
+		}
+	}
+}
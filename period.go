@@ -0,0 +1,162 @@
+package carbon
+
+import (
+	"math"
+	"time"
+)
+
+// Step 周期步长，由年、月、日等日历单位及持续时间组成
+type Step struct {
+	years    int
+	months   int
+	days     int
+	duration time.Duration
+}
+
+// Period 周期，按照步长遍历开始时间至结束时间
+type Period struct {
+	start        Carbon
+	end          Carbon
+	step         Step
+	excludeStart bool
+	excludeEnd   bool
+	Error        error
+}
+
+// StepYears N年的步长，与NextYears一致不做月份溢出
+func StepYears(years int) Step {
+	return Step{years: years}
+}
+
+// StepMonths N月的步长，与NextMonths一致不做月份溢出
+func StepMonths(months int) Step {
+	return Step{months: months}
+}
+
+// StepWeeks N周的步长
+func StepWeeks(weeks int) Step {
+	return Step{days: weeks * DaysPerWeek}
+}
+
+// StepDays N天的步长
+func StepDays(days int) Step {
+	return Step{days: days}
+}
+
+// StepDuration 持续时间步长，如time.Hour
+func StepDuration(duration time.Duration) Step {
+	return Step{duration: duration}
+}
+
+// Add 合并两个步长，如StepMonths(1).Add(StepDays(1))
+func (s Step) Add(step Step) Step {
+	s.years += step.years
+	s.months += step.months
+	s.days += step.days
+	s.duration += step.duration
+	return s
+}
+
+// IsZero 是否是零值步长
+func (s Step) IsZero() bool {
+	return s == Step{}
+}
+
+// NewPeriod 创建周期，默认包括开始时间及结束时间，步长必须向后推移
+func NewPeriod(start Carbon, end Carbon, step Step) Period {
+	p := Period{start: start, end: end, step: step}
+	switch {
+	case start.Error != nil:
+		p.Error = start.Error
+	case end.Error != nil:
+		p.Error = end.Error
+	case !p.at(1).Time.After(start.Time):
+		p.Error = invalidStepError()
+	}
+	return p
+}
+
+// Until 创建当前实例至结束时间的周期
+func (c Carbon) Until(end Carbon, step Step) Period {
+	return NewPeriod(c, end, step)
+}
+
+// ExcludeStart 不包括开始时间
+func (p Period) ExcludeStart() Period {
+	p.excludeStart = true
+	return p
+}
+
+// ExcludeEnd 不包括结束时间
+func (p Period) ExcludeEnd() Period {
+	p.excludeEnd = true
+	return p
+}
+
+// Start 获取开始时间
+func (p Period) Start() Carbon {
+	return p.start
+}
+
+// End 获取结束时间
+func (p Period) End() Carbon {
+	return p.end
+}
+
+// Each 依次遍历周期内的时间，回调函数返回false时停止遍历，步长为零或不递增(如零值Period)时不遍历
+func (p Period) Each(fn func(c Carbon) bool) {
+	if p.Error != nil || p.step.IsZero() || !p.at(1).Time.After(p.start.Time) {
+		return
+	}
+	for i := 0; ; i++ {
+		c := p.at(i)
+		if c.Time.After(p.end.Time) || (p.excludeEnd && c.Time.Equal(p.end.Time)) {
+			return
+		}
+		if i == 0 && p.excludeStart {
+			continue
+		}
+		if !fn(c) {
+			return
+		}
+	}
+}
+
+// Count 获取周期内的时间个数
+func (p Period) Count() int {
+	count := 0
+	p.Each(func(c Carbon) bool {
+		count++
+		return true
+	})
+	return count
+}
+
+// ToSlice 输出周期内的时间切片
+func (p Period) ToSlice() []Carbon {
+	slice := make([]Carbon, 0)
+	p.Each(func(c Carbon) bool {
+		slice = append(slice, c)
+		return true
+	})
+	return slice
+}
+
+// at 获取周期内第i个时间，每次均从开始时间推移，避免月末日期累计偏移
+func (p Period) at(i int) Carbon {
+	c := p.start.NextMonths(i * (p.step.years*MonthsPerYear + p.step.months))
+	c.Time = addDurations(c.Time.AddDate(0, 0, i*p.step.days), i, p.step.duration)
+	return c
+}
+
+// addDurations 推移n个持续时间，超出time.Duration范围(约292年)时分段推移，避免乘积溢出
+func addDurations(t time.Time, n int, d time.Duration) time.Time {
+	if d == 0 {
+		return t
+	}
+	limit := int(math.MaxInt64 / abs(int64(d)))
+	for ; n > limit; n -= limit {
+		t = t.Add(time.Duration(limit) * d)
+	}
+	return t.Add(time.Duration(n) * d)
+}
@@ -0,0 +1,131 @@
+package carbon
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPeriod_ToSlice(t *testing.T) {
+	Tests := []struct {
+		start  string // 输入值
+		end    string // 输入参数
+		step   Step   // 输入参数
+		output string // 期望输出值
+	}{
+		{"2020-08-05", "2020-08-08", StepDays(1), "2020-08-05,2020-08-06,2020-08-07,2020-08-08"},
+		{"2020-08-05", "2020-08-08", StepDays(2), "2020-08-05,2020-08-07"},
+		{"2020-08-05", "2020-08-25", StepWeeks(1), "2020-08-05,2020-08-12,2020-08-19"},
+		{"2020-01-31", "2020-05-31", StepMonths(1), "2020-01-31,2020-02-29,2020-03-31,2020-04-30,2020-05-31"},
+		{"2020-02-29", "2024-02-29", StepYears(2), "2020-02-29,2022-02-28,2024-02-29"},
+		{"2020-08-05", "2020-08-04", StepDays(1), ""},
+		{"2020-08-05", "2020-08-05", StepDays(1), "2020-08-05"},
+	}
+
+	for _, v := range Tests {
+		dates := make([]string, 0)
+		for _, c := range Parse(v.start).Until(Parse(v.end), v.step).ToSlice() {
+			dates = append(dates, c.ToDateString())
+		}
+		output := strings.Join(dates, ",")
+
+		if output != v.output {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.start, v.output, output)
+		}
+	}
+}
+
+func TestPeriod_Exclude(t *testing.T) {
+	p := NewPeriod(Parse("2020-08-05 00:00:00"), Parse("2020-08-05 03:00:00"), StepDuration(time.Hour))
+	Tests := []struct {
+		period Period // 输入值
+		output string // 期望输出值
+	}{
+		{p, "00:00:00,01:00:00,02:00:00,03:00:00"},
+		{p.ExcludeStart(), "01:00:00,02:00:00,03:00:00"},
+		{p.ExcludeEnd(), "00:00:00,01:00:00,02:00:00"},
+		{p.ExcludeStart().ExcludeEnd(), "01:00:00,02:00:00"},
+	}
+
+	for _, v := range Tests {
+		times := make([]string, 0)
+		v.period.Each(func(c Carbon) bool {
+			times = append(times, c.ToTimeString())
+			return true
+		})
+		output := strings.Join(times, ",")
+
+		if output != v.output {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.period.Start().ToDateTimeString(), v.output, output)
+		}
+
+		if v.period.Count() != len(times) {
+			t.Fatalf("Input %s, expected %d, but got %d\n", v.period.Start().ToDateTimeString(), len(times), v.period.Count())
+		}
+	}
+
+	count := 0
+	p.Each(func(c Carbon) bool {
+		count++
+		return count < 2
+	})
+	if count != 2 {
+		t.Fatalf("Expected the iteration to be stopped, but got %d\n", count)
+	}
+}
+
+func TestPeriod_Step(t *testing.T) {
+	step := StepMonths(1).Add(StepDays(1)).Add(StepDuration(time.Hour))
+	output := Parse("2020-01-31 13:14:15").Until(Parse("2020-03-31"), step).ToSlice()
+	if len(output) != 2 || output[1].ToDateTimeString() != "2020-03-01 14:14:15" {
+		t.Fatalf("Expected the combined step to be used, but got %v\n", output)
+	}
+
+	if !(Step{}).IsZero() || step.IsZero() {
+		t.Fatal("Expected zero step to be detected\n")
+	}
+
+	p := Timezone(Tokyo).Parse("2020-08-05").Until(Parse("2020-08-06"), StepDays(1))
+	if p.Start().ToFormatString("e") != Tokyo || p.End().ToDateString() != "2020-08-06" || p.ToSlice()[1].ToFormatString("Y-m-d e") != "2020-08-06 "+Tokyo {
+		t.Fatal("Expected the timezone of start to be kept\n")
+	}
+}
+
+func TestPeriod_LongDuration(t *testing.T) {
+	// 超过约292年后持续时间的乘积溢出，不能回退到开始时间之前
+	utc := Timezone(UTC)
+	start := utc.CreateFromGoTime(time.Date(1800, 1, 1, 0, 0, 0, 0, time.UTC))
+	end := utc.CreateFromGoTime(time.Date(2200, 1, 1, 0, 0, 0, 0, time.UTC))
+	p := NewPeriod(start, end, StepDuration(365*24*time.Hour))
+	output := p.ToSlice()
+	if len(output) != 401 || output[400].ToDateString() != "2199-09-26" {
+		t.Fatalf("Expected 401 times ending at 2199-09-26, but got %d ending at %s\n", len(output), output[len(output)-1].ToDateString())
+	}
+
+	for i := 1; i < len(output); i++ {
+		if !output[i].Gt(output[i-1]) {
+			t.Fatalf("Expected ascending times, but got %s after %s\n", output[i].ToDateString(), output[i-1].ToDateString())
+		}
+	}
+}
+
+func TestPeriod_Error(t *testing.T) {
+	Tests := []Period{
+		Parse("xxx").Until(Parse("2020-08-05"), StepDays(1)),
+		Parse("2020-08-05").Until(Parse("xxx"), StepDays(1)),
+		Parse("2020-08-05").Until(Parse("2020-08-06"), Step{}),
+		Parse("2020-08-05").Until(Parse("2020-08-06"), StepDays(-1)),
+	}
+
+	for i, p := range Tests {
+		if p.Error == nil || p.Count() != 0 || len(p.ToSlice()) != 0 {
+			t.Fatalf("Input %d, expected error, but got nil\n", i)
+		}
+	}
+	// 未通过NewPeriod或Until创建的周期不能无限遍历
+	for i, p := range []Period{{}, {start: Parse("2020-08-05"), end: Parse("2020-08-06")}, {start: Parse("2020-08-05"), end: Parse("2020-08-06"), step: StepDays(-1)}} {
+		if p.Count() != 0 || len(p.ToSlice()) != 0 {
+			t.Fatalf("Input %d, expected no time, but got %d\n", i, p.Count())
+		}
+	}
+}
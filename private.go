@@ -1,60 +1,214 @@
 package carbon
 
 import (
+	"fmt"
+	"reflect"
 	"strings"
 	"time"
 )
 
-// format转layout
-func format2layout(format string) string {
-	layout := strings.Replace(format, "Y", "2006", 1)
-	layout = strings.Replace(layout, "y", "06", 1)
-	layout = strings.Replace(layout, "M", "Jan", 1)
-	layout = strings.Replace(layout, "m", "01", 1)
-	layout = strings.Replace(layout, "F", "January", 1)
-	layout = strings.Replace(layout, "n", "1", 1)
-	layout = strings.Replace(layout, "l", "Monday", 1)
-	layout = strings.Replace(layout, "D", "Mon", 1)
-	layout = strings.Replace(layout, "d", "02", 1)
-	layout = strings.Replace(layout, "j", "2", 1)
-	layout = strings.Replace(layout, "H", "15", 1)
-	layout = strings.Replace(layout, "h", "03", 1)
-	layout = strings.Replace(layout, "i", "04", 1)
-	layout = strings.Replace(layout, "s", "05", 1)
-	layout = strings.Replace(layout, "P", "PM", 1)
-	layout = strings.Replace(layout, "p", "pm", 1)
-	return layout
-}
+// 每秒最大纳秒数，用于结束时间
+const maxNanosecond = 999999999
 
 // newCarbon 创建一个新Carbon实例
 func newCarbon(t time.Time) Carbon {
-	loc, _ := time.LoadLocation(Local)
-	return Carbon{Time: t, loc: loc}
+	return Carbon{Time: t, loc: time.Local}
+}
+
+// inLocation 将新实例切换到当前实例的时区并沿用当前实例的区域，当前实例或新实例存在错误时返回首个错误
+func (c Carbon) inLocation(n Carbon) Carbon {
+	if c.Error != nil {
+		return c
+	}
+	if n.Error != nil {
+		return c.withError(n.Error)
+	}
+	c.Time = n.Time.In(c.location())
+	return c
+}
+
+// setTime 设置时间及时区并清除错误，保留区域、时钟等实例设置
+func (c *Carbon) setTime(t time.Time, loc *time.Location) {
+	c.Time, c.loc, c.Error = t, loc, nil
+}
+
+// withError 记录错误并清空时间，保留区域、时钟等实例设置
+func (c Carbon) withError(err error) Carbon {
+	c.Time, c.Error = time.Time{}, err
+	return c
+}
+
+// location 获取当前实例的时区，未设置时返回本地时区
+func (c Carbon) location() *time.Location {
+	if c.loc == nil {
+		return time.Local
+	}
+	return c.loc
+}
+
+// abs 获取绝对值
+func abs(value int64) int64 {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
+
+// clockOfMonth 获取自本月1日零点起经过的时长，用于比较两个时间在月内的先后
+func clockOfMonth(t time.Time) time.Duration {
+	hour, minute, second := t.Clock()
+	return time.Duration(t.Day())*HoursPerDay*time.Hour + time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute + time.Duration(second)*time.Second + time.Duration(t.Nanosecond())
 }
 
 // getLocalByTimezone 通过时区获取Location实例
-func getLocalByTimezone(timezone string) *time.Location {
+func getLocalByTimezone(timezone string) (*time.Location, error) {
 	loc, err := time.LoadLocation(timezone)
 	if err != nil {
-		panic("invalid timezone \"" + timezone + "\", all valid timezone, please see the $GOROOT/lib/time/zoneinfo.zip file")
+		return nil, invalidTimezoneError(timezone)
 	}
-	return loc
+	return loc, nil
+}
+
+// isZeroValue 是否是零值时间字符串
+func isZeroValue(value string) bool {
+	return value == "" || value == "0" || value == "0000-00-00 00:00:00" || value == "0000-00-00" || value == "00:00:00"
+}
+
+// guessLayout 根据时间字符串的长度及分隔符推断布局模板
+func guessLayout(value string) string {
+	layout := DateTimeFormat
+
+	if len(value) == 10 && strings.Count(value, "-") == 2 {
+		layout = DateFormat
+	}
+
+	if len(value) == 14 {
+		layout = ShortDateTimeFormat
+	}
+
+	if len(value) == 8 {
+		layout = ShortDateFormat
+	}
+
+	if strings.Index(value, "T") == 10 {
+		layout = RFC3339Format
+	}
+	return layout
 }
 
 // parseByLayout 通过布局模板解析
-func parseByLayout(value string, layout string) time.Time {
-	t, err := time.ParseInLocation(layout, value, getLocalByTimezone(Local))
+func parseByLayout(value string, layout string) (time.Time, error) {
+	t, err := time.ParseInLocation(layout, value, time.Local)
 	if err != nil {
-		panic("the value \"" + value + "\" and layout \"" + layout + "\" don't match")
+		return t, invalidValueError(value, layout)
 	}
-	return t
+	return t, nil
 }
 
-// parseByDuration 通过持续时间解析
-func parseByDuration(duration string) time.Duration {
-	d, err := time.ParseDuration(duration)
-	if err != nil {
-		panic("invalid duration \"" + duration + "\"")
+// invalidTimezoneError 无效的时区错误
+func invalidTimezoneError(timezone string) error {
+	return fmt.Errorf("invalid timezone %q, all valid timezone, please see the $GOROOT/lib/time/zoneinfo.zip file", timezone)
+}
+
+// invalidValueError 时间字符串与布局模板不匹配错误
+func invalidValueError(value string, layout string) error {
+	return fmt.Errorf("the value %q and layout %q don't match", value, layout)
+}
+
+// invalidFormatError 时间字符串与格式化符号不匹配错误
+func invalidFormatError(value string, format string) error {
+	return fmt.Errorf("the value %q and format %q don't match", value, format)
+}
+
+// invalidDurationError 无效的持续时间错误
+func invalidDurationError(duration string) error {
+	return fmt.Errorf("invalid duration %q", duration)
+}
+
+// invalidLunarError 无效的农历日期错误
+func invalidLunarError(year int, month int, day int, isLeapMonth bool) error {
+	if isLeapMonth {
+		return fmt.Errorf("invalid lunar date %d-leap%02d-%02d, the supported lunar year range is %d-%d", year, month, day, minLunarYear, maxLunarYear)
 	}
-	return d
+	return fmt.Errorf("invalid lunar date %d-%02d-%02d, the supported lunar year range is %d-%d", year, month, day, minLunarYear, maxLunarYear)
+}
+
+// invalidLocaleError 无效的区域错误
+func invalidLocaleError(locale string) error {
+	return fmt.Errorf("invalid locale %q, please see the lang.go file for all supported locales", locale)
+}
+
+// loadLocaleError 加载语言包文件错误
+func loadLocaleError(file string, err error) error {
+	return fmt.Errorf("load locale file %q failed: %w", file, err)
+}
+
+// invalidTimestampError 无效的时间戳错误
+func invalidTimestampError(timestamp string) error {
+	return fmt.Errorf("invalid timestamp %q", timestamp)
+}
+
+// invalidScanError 无法转换的数据库值错误
+func invalidScanError(value interface{}) error {
+	return fmt.Errorf("can not convert %v to timestamp", value)
+}
+
+// invalidMonthError 无效的月份错误
+func invalidMonthError(month int) error {
+	return fmt.Errorf("invalid month %d, the valid month range is 1-12", month)
+}
+
+// invalidWeekdayError 无效的星期错误
+func invalidWeekdayError(day time.Weekday) error {
+	return fmt.Errorf("invalid weekday %d, the valid weekday range is 0(Sunday)-6(Saturday)", day)
+}
+
+// invalidBusinessCalendarError 无效的工作日历错误
+func invalidBusinessCalendarError() error {
+	return fmt.Errorf("invalid business calendar, no business day found within a year")
+}
+
+// loadBusinessCalendarError 加载工作日历文件错误
+func loadBusinessCalendarError(file string, err error) error {
+	return fmt.Errorf("load business calendar file %q failed: %w", file, err)
+}
+
+// loadChineseHolidaysError 加载中国法定节假日文件错误
+func loadChineseHolidaysError(file string, err error) error {
+	return fmt.Errorf("load chinese holidays file %q failed: %w", file, err)
+}
+
+// invalidSolarTermError 超出节气支持范围错误
+func invalidSolarTermError(year int) error {
+	return fmt.Errorf("invalid solar term year %d, the supported year range is %d-%d", year, minSolarTermYear, maxSolarTermYear)
+}
+
+// invalidGanZhiYearBoundaryError 无效的干支纪年换年时刻错误
+func invalidGanZhiYearBoundaryError(boundary GanZhiYearBoundary) error {
+	return fmt.Errorf("invalid ganzhi year boundary %d, please use BeginningOfSpring or LunarNewYear", boundary)
+}
+
+// invalidStepError 无效的周期步长错误
+func invalidStepError() error {
+	return fmt.Errorf("invalid step, the step must move forward in time")
+}
+
+// invalidIntervalError 无效的时间区间错误
+func invalidIntervalError(start Carbon, end Carbon) error {
+	return fmt.Errorf("invalid interval, the end %q is before the start %q", end.ToDateTimeString(), start.ToDateTimeString())
+}
+
+// invalidISODurationError 无效的ISO8601持续时间错误
+func invalidISODurationError(duration string) error {
+	return fmt.Errorf("invalid ISO8601 duration %q", duration)
+}
+
+// invalidTagError 无效的carbon标签错误
+func invalidTagError(tag string) error {
+	return fmt.Errorf("invalid carbon tag %q, please use layout=... or format=...", tag)
+}
+
+// invalidTagFieldError 无效的carbon标签字段错误
+func invalidTagFieldError(field reflect.StructField) error {
+	return fmt.Errorf("invalid carbon tag on field %s of type %s, only Carbon and *Carbon fields are supported", field.Name, field.Type)
 }
@@ -0,0 +1,145 @@
+package carbon
+
+import (
+	"sync"
+	"time"
+)
+
+var (
+	// 全局财年起始月份
+	globalFiscalYearStartMonth = int(time.January)
+
+	// 财年起始月份读写锁
+	fiscalMutex sync.RWMutex
+)
+
+// SetFiscalYearStartMonth 设置全局财年起始月份，默认为1月(即自然年)
+func SetFiscalYearStartMonth(month int) error {
+	if month < 1 || month > MonthsPerYear {
+		return invalidMonthError(month)
+	}
+	fiscalMutex.Lock()
+	defer fiscalMutex.Unlock()
+	globalFiscalYearStartMonth = month
+	return nil
+}
+
+// GetFiscalYearStartMonth 获取全局财年起始月份
+func GetFiscalYearStartMonth() int {
+	fiscalMutex.RLock()
+	defer fiscalMutex.RUnlock()
+	return globalFiscalYearStartMonth
+}
+
+// SetFiscalYearStartMonth 设置财年起始月份(仅对当前实例有效)
+func (c Carbon) SetFiscalYearStartMonth(month int) Carbon {
+	if c.Error != nil {
+		return c
+	}
+	if month < 1 || month > MonthsPerYear {
+		return c.withError(invalidMonthError(month))
+	}
+	c.fiscalYearStartMonth = month
+	return c
+}
+
+// GetFiscalYearStartMonth 获取当前实例财年起始月份，未设置时返回全局财年起始月份
+func (c Carbon) GetFiscalYearStartMonth() int {
+	if c.fiscalYearStartMonth == 0 {
+		return GetFiscalYearStartMonth()
+	}
+	return c.fiscalYearStartMonth
+}
+
+// Quarter 获取当前财年的第几季度
+func (c Carbon) Quarter() int {
+	if c.Time.IsZero() {
+		return 0
+	}
+	return c.monthOfFiscalYear()/MonthsPerQuarter + 1
+}
+
+// BeginningOfQuarter 本季度开始时间
+func (c Carbon) BeginningOfQuarter() Carbon {
+	if c.Error != nil {
+		return c
+	}
+	month := int(c.Time.Month()) - c.monthOfFiscalYear()%MonthsPerQuarter
+	c.Time = time.Date(c.Time.Year(), time.Month(month), 1, 0, 0, 0, 0, c.location())
+	return c
+}
+
+// EndOfQuarter 本季度结束时间
+func (c Carbon) EndOfQuarter() Carbon {
+	if c.Error != nil {
+		return c
+	}
+	month := int(c.Time.Month()) - c.monthOfFiscalYear()%MonthsPerQuarter + MonthsPerQuarter
+	c.Time = time.Date(c.Time.Year(), time.Month(month), 0, 23, 59, 59, maxNanosecond, c.location())
+	return c
+}
+
+// BeginningOfFiscalYear 本财年开始时间
+func (c Carbon) BeginningOfFiscalYear() Carbon {
+	if c.Error != nil {
+		return c
+	}
+	month := int(c.Time.Month()) - c.monthOfFiscalYear()
+	c.Time = time.Date(c.Time.Year(), time.Month(month), 1, 0, 0, 0, 0, c.location())
+	return c
+}
+
+// EndOfFiscalYear 本财年结束时间
+func (c Carbon) EndOfFiscalYear() Carbon {
+	if c.Error != nil {
+		return c
+	}
+	month := int(c.Time.Month()) - c.monthOfFiscalYear() + MonthsPerYear
+	c.Time = time.Date(c.Time.Year(), time.Month(month), 0, 23, 59, 59, maxNanosecond, c.location())
+	return c
+}
+
+// AddQuarters N季度后(不溢出月份，如01-31加1季度为04-30)
+func (c Carbon) AddQuarters(quarters int) Carbon {
+	return c.NextMonths(quarters * MonthsPerQuarter)
+}
+
+// AddQuarter 1季度后
+func (c Carbon) AddQuarter() Carbon {
+	return c.AddQuarters(1)
+}
+
+// SubQuarters N季度前(不溢出月份)
+func (c Carbon) SubQuarters(quarters int) Carbon {
+	return c.NextMonths(-quarters * MonthsPerQuarter)
+}
+
+// SubQuarter 1季度前
+func (c Carbon) SubQuarter() Carbon {
+	return c.SubQuarters(1)
+}
+
+// IsFirstQuarter 是否是第一季度
+func (c Carbon) IsFirstQuarter() bool {
+	return c.Quarter() == 1
+}
+
+// IsSecondQuarter 是否是第二季度
+func (c Carbon) IsSecondQuarter() bool {
+	return c.Quarter() == 2
+}
+
+// IsThirdQuarter 是否是第三季度
+func (c Carbon) IsThirdQuarter() bool {
+	return c.Quarter() == 3
+}
+
+// IsFourthQuarter 是否是第四季度
+func (c Carbon) IsFourthQuarter() bool {
+	return c.Quarter() == 4
+}
+
+// monthOfFiscalYear 获取自财年起始月份起经过的月数(0-11)
+func (c Carbon) monthOfFiscalYear() int {
+	return (int(c.Time.Month()) - c.GetFiscalYearStartMonth() + MonthsPerYear) % MonthsPerYear
+}
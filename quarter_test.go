@@ -0,0 +1,127 @@
+package carbon
+
+import "testing"
+
+func TestCarbon_Quarter(t *testing.T) {
+	Tests := []struct {
+		input     string // 输入值
+		quarter   int    // 期望季度
+		beginning string // 期望季度开始时间
+		end       string // 期望季度结束时间
+	}{
+		{"2020-01-01 13:14:15", 1, "2020-01-01 00:00:00", "2020-03-31 23:59:59"},
+		{"2020-03-31 13:14:15", 1, "2020-01-01 00:00:00", "2020-03-31 23:59:59"},
+		{"2020-04-01 13:14:15", 2, "2020-04-01 00:00:00", "2020-06-30 23:59:59"},
+		{"2020-08-05 13:14:15", 3, "2020-07-01 00:00:00", "2020-09-30 23:59:59"},
+		{"2020-12-31 13:14:15", 4, "2020-10-01 00:00:00", "2020-12-31 23:59:59"},
+	}
+
+	for _, v := range Tests {
+		c := Parse(v.input)
+
+		if c.Quarter() != v.quarter {
+			t.Fatalf("Input %s, expected quarter %d, but got %d\n", v.input, v.quarter, c.Quarter())
+		}
+
+		if output := c.BeginningOfQuarter().ToDateTimeString(); output != v.beginning {
+			t.Fatalf("Input %s, expected beginning %s, but got %s\n", v.input, v.beginning, output)
+		}
+
+		if output := c.EndOfQuarter().ToDateTimeString(); output != v.end {
+			t.Fatalf("Input %s, expected end %s, but got %s\n", v.input, v.end, output)
+		}
+
+		is := []bool{c.IsFirstQuarter(), c.IsSecondQuarter(), c.IsThirdQuarter(), c.IsFourthQuarter()}
+		for i, b := range is {
+			if b != (i+1 == v.quarter) {
+				t.Fatalf("Input %s, unexpected Is*Quarter result %v\n", v.input, is)
+			}
+		}
+	}
+
+	if Parse("0000-00-00").Quarter() != 0 {
+		t.Fatal("Expected quarter 0 for zero value\n")
+	}
+}
+
+func TestCarbon_FiscalQuarter(t *testing.T) {
+	Tests := []struct {
+		input      string // 输入值
+		startMonth int    // 财年起始月份
+		quarter    int    // 期望季度
+		beginning  string // 期望季度开始时间
+		end        string // 期望季度结束时间
+		yearStart  string // 期望财年开始时间
+		yearEnd    string // 期望财年结束时间
+	}{
+		{"2020-04-01", 4, 1, "2020-04-01", "2020-06-30", "2020-04-01", "2021-03-31"},
+		{"2021-03-31", 4, 4, "2021-01-01", "2021-03-31", "2020-04-01", "2021-03-31"},
+		{"2020-08-05", 10, 4, "2020-07-01", "2020-09-30", "2019-10-01", "2020-09-30"},
+		{"2020-10-01", 10, 1, "2020-10-01", "2020-12-31", "2020-10-01", "2021-09-30"},
+		{"2020-01-15", 2, 4, "2019-11-01", "2020-01-31", "2019-02-01", "2020-01-31"},
+		{"2020-02-29", 2, 1, "2020-02-01", "2020-04-30", "2020-02-01", "2021-01-31"},
+		{"2020-08-05", 1, 3, "2020-07-01", "2020-09-30", "2020-01-01", "2020-12-31"},
+	}
+
+	for _, v := range Tests {
+		c := Parse(v.input).SetFiscalYearStartMonth(v.startMonth)
+
+		if c.Quarter() != v.quarter {
+			t.Fatalf("Input %s(%d), expected quarter %d, but got %d\n", v.input, v.startMonth, v.quarter, c.Quarter())
+		}
+
+		output := []string{c.BeginningOfQuarter().ToDateString(), c.EndOfQuarter().ToDateString(), c.BeginningOfFiscalYear().ToDateString(), c.EndOfFiscalYear().ToDateString()}
+		expected := []string{v.beginning, v.end, v.yearStart, v.yearEnd}
+		for i := range output {
+			if output[i] != expected[i] {
+				t.Fatalf("Input %s(%d), expected %v, but got %v\n", v.input, v.startMonth, expected, output)
+			}
+		}
+	}
+
+	if err := SetFiscalYearStartMonth(4); err != nil {
+		t.Fatalf("Unexpected error %v\n", err)
+	}
+	defer SetFiscalYearStartMonth(1)
+
+	if GetFiscalYearStartMonth() != 4 || Parse("2020-04-01").Quarter() != 1 || Parse("2020-04-01").SetFiscalYearStartMonth(1).Quarter() != 2 {
+		t.Fatal("Expected the global fiscal year start month to be used\n")
+	}
+
+	// 实例设置在链式调用中传递
+	if Parse("2020-04-01").SetFiscalYearStartMonth(1).AddDays(1).Timezone(Tokyo).GetFiscalYearStartMonth() != 1 {
+		t.Fatal("Expected the instance fiscal year start month to be passed along the chain\n")
+	}
+
+	if SetFiscalYearStartMonth(13) == nil || Parse("2020-04-01").SetFiscalYearStartMonth(0).Error == nil {
+		t.Fatal("Expected error with an invalid month\n")
+	}
+}
+
+func TestCarbon_AddQuarters(t *testing.T) {
+	Tests := []struct {
+		input    string // 输入值
+		quarters int    // 输入参数
+		add      string // 期望AddQuarters输出值
+		sub      string // 期望SubQuarters输出值
+	}{
+		{"2020-01-01", 1, "2020-04-01", "2019-10-01"},
+		{"2020-01-31", 1, "2020-04-30", "2019-10-31"},
+		{"2020-05-31", 3, "2021-02-28", "2019-08-31"},
+		{"2020-08-05", 0, "2020-08-05", "2020-08-05"},
+	}
+
+	for _, v := range Tests {
+		if output := Parse(v.input).AddQuarters(v.quarters).ToDateString(); output != v.add {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.add, output)
+		}
+
+		if output := Parse(v.input).SubQuarters(v.quarters).ToDateString(); output != v.sub {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.sub, output)
+		}
+	}
+
+	if Parse("2020-11-30").AddQuarter().ToDateString() != "2021-02-28" || Parse("2020-05-31").SubQuarter().ToDateString() != "2020-02-29" {
+		t.Fatal("Unexpected AddQuarter or SubQuarter result\n")
+	}
+}
@@ -0,0 +1,236 @@
+package carbon
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	minSolarTermYear = 1900 // 支持的最小节气年
+	maxSolarTermYear = 2100 // 支持的最大节气年
+)
+
+var (
+	// 二十四节气，按公历年内顺序排列，小寒对应太阳黄经285度，此后每个节气递增15度
+	SolarTermNames = [24]string{"小寒", "大寒", "立春", "雨水", "惊蛰", "春分", "清明", "谷雨", "立夏", "小满", "芒种", "夏至", "小暑", "大暑", "立秋", "处暑", "白露", "秋分", "寒露", "霜降", "立冬", "小雪", "大雪", "冬至"}
+
+	// 节气日期以北京时间为准
+	solarTermLocation = time.FixedZone("CST", 8*SecondsPerHour)
+
+	// 地球日心黄经VSOP87截断级数(振幅单位1e-8弧度，相位单位弧度，频率单位弧度/千年)，精度约1角秒
+	earthLongitudeSeries = [...][][3]float64{
+		{
+			{175347046, 0, 0}, {3341656, 4.6692568, 6283.07585}, {34894, 4.6261, 12566.1517}, {3497, 2.7441, 5753.3849},
+			{3418, 2.8289, 3.5231}, {3136, 3.6277, 77713.7715}, {2676, 4.4181, 7860.4194}, {2343, 6.1352, 3930.2097},
+			{1324, 0.7425, 11506.7698}, {1273, 2.0371, 529.691}, {1199, 1.1096, 1577.3435}, {990, 5.233, 5884.927},
+			{902, 2.045, 26.298}, {857, 3.508, 398.149}, {780, 1.179, 5223.694}, {753, 2.533, 5507.553},
+			{505, 4.583, 18849.228}, {492, 4.205, 775.523}, {357, 2.92, 0.067}, {317, 5.849, 11790.629},
+			{284, 1.899, 796.298}, {271, 0.315, 10977.079}, {243, 0.345, 5486.778}, {206, 4.806, 2544.314},
+			{205, 1.869, 5573.143}, {202, 2.458, 6069.777}, {156, 0.833, 213.299}, {132, 3.411, 2942.463},
+			{126, 1.083, 20.775}, {115, 0.645, 0.98}, {103, 0.636, 4694.003}, {102, 0.976, 15720.839},
+			{102, 4.267, 7.114}, {99, 6.21, 2146.17}, {98, 0.68, 155.42}, {86, 5.98, 161000.69},
+			{85, 1.3, 6275.96}, {85, 3.67, 71430.7}, {80, 1.81, 17260.15}, {79, 3.04, 12036.46},
+			{75, 1.76, 5088.63}, {74, 3.5, 3154.69}, {74, 4.68, 801.82}, {70, 0.83, 9437.76},
+			{62, 3.98, 8827.39}, {61, 1.82, 7084.9}, {57, 2.78, 6286.6}, {56, 4.39, 14143.5},
+			{56, 3.47, 6279.55}, {52, 0.19, 12139.55}, {52, 1.33, 1748.02}, {51, 0.28, 5856.48},
+			{49, 0.49, 1194.45}, {41, 5.37, 8429.24}, {41, 2.4, 19651.05}, {39, 6.17, 10447.39},
+			{37, 6.04, 10213.29}, {37, 2.57, 1059.38}, {36, 1.71, 2352.87}, {36, 1.78, 6812.77},
+			{33, 0.59, 17789.85}, {30, 0.44, 83996.85}, {30, 2.74, 1349.87}, {25, 3.16, 4690.48},
+		},
+		{
+			{628331966747, 0, 0}, {206059, 2.678235, 6283.07585}, {4303, 2.6351, 12566.1517}, {425, 1.59, 3.523},
+			{119, 5.796, 26.298}, {109, 2.966, 1577.344}, {93, 2.59, 18849.23}, {72, 1.14, 529.69},
+			{68, 1.87, 398.15}, {67, 4.41, 5507.55}, {59, 2.89, 5223.69}, {56, 2.17, 155.42},
+			{45, 0.4, 796.3}, {36, 0.47, 775.52}, {29, 2.65, 7.11}, {21, 5.34, 0.98},
+			{19, 1.85, 5486.78}, {19, 4.97, 213.3}, {17, 2.99, 6275.96}, {16, 0.03, 2544.31},
+			{16, 1.43, 2146.17}, {15, 1.21, 10977.08}, {12, 2.83, 1748.02}, {12, 3.26, 5088.63},
+			{12, 5.27, 1194.45}, {12, 2.08, 4694}, {11, 0.77, 553.57}, {10, 1.3, 6286.6},
+			{10, 4.24, 1349.87}, {9, 2.7, 242.73}, {9, 5.64, 951.72}, {8, 5.3, 2352.87},
+			{6, 2.65, 9437.76}, {6, 4.67, 4690.48},
+		},
+		{
+			{52919, 0, 0}, {8720, 1.0721, 6283.0758}, {309, 0.867, 12566.152}, {27, 0.05, 3.52},
+			{16, 5.19, 26.3}, {16, 3.68, 155.42}, {10, 0.76, 18849.23}, {9, 2.06, 77713.77},
+			{7, 0.83, 775.52}, {5, 4.66, 1577.34}, {4, 1.03, 7.11}, {4, 3.44, 5573.14},
+			{3, 5.14, 796.3}, {3, 6.05, 5507.55}, {3, 1.19, 242.73}, {3, 6.12, 529.69},
+			{3, 0.31, 398.15}, {3, 2.28, 553.57}, {2, 4.38, 5223.69}, {2, 3.75, 0.98},
+		},
+		{
+			{289, 5.844, 6283.076}, {35, 0, 0}, {17, 5.49, 12566.15}, {3, 5.2, 155.42},
+			{1, 4.72, 3.52}, {1, 5.3, 18849.23}, {1, 5.97, 242.73},
+		},
+		{
+			{114, 3.142, 0}, {8, 4.13, 6283.08}, {1, 3.84, 12566.15},
+		},
+		{
+			{1, 3.14, 0},
+		},
+	}
+)
+
+// SolarTerm 节气
+type SolarTerm struct {
+	Name   string // 节气名称
+	Carbon Carbon // 交节时刻
+}
+
+// SolarTermsInYear 获取公历年的二十四节气及交节时刻(北京时间)，超出1900-2100年范围时返回nil
+func SolarTermsInYear(year int) []SolarTerm {
+	return Carbon{loc: solarTermLocation}.SolarTermsInYear(year)
+}
+
+// SolarTermsInYear 获取公历年的二十四节气及交节时刻(指定时区)，超出1900-2100年范围时返回nil
+// 节气日期始终以北京时间为准，其他时区下交节时刻的日期可能与节气日期不同
+func (c Carbon) SolarTermsInYear(year int) []SolarTerm {
+	if year < minSolarTermYear || year > maxSolarTermYear {
+		return nil
+	}
+	terms := make([]SolarTerm, len(SolarTermNames))
+	for index, name := range SolarTermNames {
+		c.Time = solarTermTime(year, index).In(c.location())
+		terms[index] = SolarTerm{Name: name, Carbon: c}
+	}
+	return terms
+}
+
+// SolarTerm 获取节气名称，按照北京时间判断当天是否是交节日期，不是节气时返回空字符串
+func (c Carbon) SolarTerm() string {
+	if c.Time.IsZero() {
+		return ""
+	}
+	year, month, day := c.beijingTime().Date()
+	if year < minSolarTermYear || year > maxSolarTermYear {
+		return ""
+	}
+	// 每月有两个节气，分别位于上半月和下半月
+	index := (int(month) - 1) * 2
+	if day > 15 {
+		index++
+	}
+	if t := solarTermTime(year, index); t.Day() == day {
+		return SolarTermNames[index]
+	}
+	return ""
+}
+
+// IsSolarTerm 是否是节气
+func (c Carbon) IsSolarTerm() bool {
+	return c.SolarTerm() != ""
+}
+
+// NextSolarTerm 下一个节气的交节时刻，超出1900-2100年范围时返回错误
+func (c Carbon) NextSolarTerm() Carbon {
+	if c.Error != nil || c.Time.IsZero() {
+		return c
+	}
+	year := c.termYear()
+	for y := year; y <= year+1 && y <= maxSolarTermYear; y++ {
+		for index := range SolarTermNames {
+			if y < minSolarTermYear {
+				break
+			}
+			if t := solarTermTime(y, index); t.After(c.Time) {
+				c.Time = t.In(c.location())
+				return c
+			}
+		}
+	}
+	return c.withError(invalidSolarTermError(year))
+}
+
+// PrevSolarTerm 上一个节气的交节时刻，超出1900-2100年范围时返回错误
+func (c Carbon) PrevSolarTerm() Carbon {
+	if c.Error != nil || c.Time.IsZero() {
+		return c
+	}
+	year := c.termYear()
+	for y := year; y >= year-1 && y >= minSolarTermYear; y-- {
+		for index := len(SolarTermNames) - 1; index >= 0; index-- {
+			if y > maxSolarTermYear {
+				break
+			}
+			if t := solarTermTime(y, index); t.Before(c.Time) {
+				c.Time = t.In(c.location())
+				return c
+			}
+		}
+	}
+	return c.withError(invalidSolarTermError(year))
+}
+
+// beijingTime 获取北京时间
+func (c Carbon) beijingTime() time.Time {
+	return c.Time.In(solarTermLocation)
+}
+
+// solarTermTime 计算公历年第index个节气的交节时刻(北京时间，精确到秒)
+func solarTermTime(year int, index int) time.Time {
+	longitude := math.Mod(float64(285+index*15), 360)
+	// 以平均节气间隔估算初值，再用牛顿迭代逼近太阳视黄经
+	jd := julianDay(time.Date(year, time.January, 6, 0, 0, 0, 0, time.UTC)) + float64(index)*365.2422/24
+	for i := 0; i < 10; i++ {
+		delta := math.Mod(longitude-sunApparentLongitude(jd+deltaT(year)/SecondsPerDay)+540, 360) - 180
+		jd += delta * 365.2422 / 360
+		if math.Abs(delta) < 1e-7 {
+			break
+		}
+	}
+	seconds := math.Round((jd - 2440587.5) * SecondsPerDay)
+	return time.Unix(int64(seconds), 0).In(solarTermLocation)
+}
+
+// sunApparentLongitude 计算力学时儒略日对应的太阳视黄经(度)
+func sunApparentLongitude(jde float64) float64 {
+	tau := (jde - 2451545) / 365250
+	l := 0.0
+	for i, series := range earthLongitudeSeries {
+		sum := 0.0
+		for _, term := range series {
+			sum += term[0] * math.Cos(term[1]+term[2]*tau)
+		}
+		l += sum * math.Pow(tau, float64(i))
+	}
+	// 地心黄经 = 日心黄经 + 180度
+	longitude := l/1e8*180/math.Pi + 180
+
+	// FK5修正、章动及光行差(角秒)
+	t := tau * 10
+	omega := (125.04452 - 1934.136261*t) * math.Pi / 180
+	sun := (280.4665 + 36000.7698*t) * math.Pi / 180
+	moon := (218.3165 + 481267.8813*t) * math.Pi / 180
+	nutation := -17.2*math.Sin(omega) - 1.32*math.Sin(2*sun) - 0.23*math.Sin(2*moon) + 0.21*math.Sin(2*omega)
+	longitude += (-0.09033 + nutation - 20.4898) / 3600
+	return math.Mod(math.Mod(longitude, 360)+360, 360)
+}
+
+// julianDay 获取世界时对应的儒略日
+func julianDay(t time.Time) float64 {
+	return float64(t.Unix())/SecondsPerDay + 2440587.5
+}
+
+// deltaT 获取力学时与世界时之差(秒)，采用Espenak-Meeus多项式
+func deltaT(year int) float64 {
+	y := float64(year) + 0.5
+	switch {
+	case y < 1920:
+		t := y - 1900
+		return -2.79 + 1.494119*t - 0.0598939*t*t + 0.0061966*t*t*t - 0.000197*t*t*t*t
+	case y < 1941:
+		t := y - 1920
+		return 21.2 + 0.84493*t - 0.0761*t*t + 0.0020936*t*t*t
+	case y < 1961:
+		t := y - 1950
+		return 29.07 + 0.407*t - t*t/233 + t*t*t/2547
+	case y < 1986:
+		t := y - 1975
+		return 45.45 + 1.067*t - t*t/260 - t*t*t/718
+	case y < 2005:
+		t := y - 2000
+		return 63.86 + 0.3345*t - 0.060374*t*t + 0.0017275*t*t*t + 0.000651814*t*t*t*t + 0.00002373599*t*t*t*t*t
+	case y < 2050:
+		t := y - 2000
+		return 62.92 + 0.32217*t + 0.005589*t*t
+	}
+	u := (y - 1820) / 100
+	return -20 + 32*u*u - 0.5628*(2150-y)
+}
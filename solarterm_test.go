@@ -0,0 +1,151 @@
+package carbon
+
+import (
+	"testing"
+	"time"
+)
+
+// parseInBeijing 按照北京时间解析时间字符串，使节气及干支的测试结果与本机时区无关
+func parseInBeijing(value string) Carbon {
+	if isZeroValue(value) {
+		return Carbon{loc: solarTermLocation}
+	}
+	t, err := time.ParseInLocation(guessLayout(value), value, solarTermLocation)
+	if err != nil {
+		return Carbon{loc: solarTermLocation, Error: err}
+	}
+	return Carbon{Time: t, loc: solarTermLocation}
+}
+
+func TestCarbon_SolarTerm(t *testing.T) {
+	Tests := []struct {
+		input  string // 输入值
+		output string // 期望输出值
+	}{
+		{"0000-00-00", ""},
+		{"1899-12-22", ""},
+		{"1984-02-04", "立春"},
+		{"2017-02-03", "立春"},
+		{"2017-12-22", "冬至"},
+		{"2020-01-06 13:14:15", "小寒"},
+		{"2020-02-04", "立春"},
+		{"2020-02-05", ""},
+		{"2020-03-20", "春分"},
+		{"2020-06-21", "夏至"},
+		{"2020-08-05", ""},
+		{"2020-08-07", "立秋"},
+		{"2020-09-22", "秋分"},
+		{"2020-12-21", "冬至"},
+		{"2021-12-21", "冬至"},
+		{"2021-12-22", ""},
+	}
+
+	for _, v := range Tests {
+		c := parseInBeijing(v.input)
+		output := c.SolarTerm()
+
+		if output != v.output {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.output, output)
+		}
+
+		if c.IsSolarTerm() != (v.output != "") {
+			t.Fatalf("Input %s, expected %t, but got %t\n", v.input, v.output != "", c.IsSolarTerm())
+		}
+	}
+
+	// 2020-01-05 21:30(UTC)即北京时间2020-01-06 05:30交小寒
+	if c := CreateFromGoTime(time.Date(2020, 1, 5, 23, 0, 0, 0, time.UTC)); c.SolarTerm() != "小寒" {
+		t.Fatalf("Expected the beijing date to be used, but got %q\n", c.SolarTerm())
+	}
+
+	if c := CreateFromGoTime(time.Date(2020, 1, 5, 12, 0, 0, 0, time.UTC)); c.SolarTerm() != "" {
+		t.Fatalf("Expected the beijing date to be used, but got %q\n", c.SolarTerm())
+	}
+}
+
+func TestCarbon_NextSolarTerm(t *testing.T) {
+	Tests := []struct {
+		input string // 输入值
+		next  string // 期望下一个节气
+		prev  string // 期望上一个节气
+	}{
+		{"2020-08-05 13:14:15", "2020-08-07", "2020-07-22"},
+		{"2020-12-25", "2021-01-05", "2020-12-21"},
+		{"2021-01-03", "2021-01-05", "2020-12-21"},
+		{"2020-02-04 17:00:00", "2020-02-04", "2020-01-20"},
+		{"2020-02-04 17:10:00", "2020-02-19", "2020-02-04"},
+	}
+
+	for _, v := range Tests {
+		next := parseInBeijing(v.input).NextSolarTerm()
+		prev := parseInBeijing(v.input).PrevSolarTerm()
+
+		if next.ToDateString() != v.next {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.next, next.ToDateString())
+		}
+
+		if prev.ToDateString() != v.prev {
+			t.Fatalf("Input %s, expected %s, but got %s\n", v.input, v.prev, prev.ToDateString())
+		}
+
+		if !next.IsSolarTerm() || !prev.IsSolarTerm() {
+			t.Fatalf("Input %s, expected solar terms, but got %s and %s\n", v.input, next.ToDateString(), prev.ToDateString())
+		}
+	}
+
+	if parseInBeijing("2100-12-25").NextSolarTerm().Error == nil || parseInBeijing("1900-01-02").PrevSolarTerm().Error == nil {
+		t.Fatal("Expected error with a year out of range\n")
+	}
+
+	if !parseInBeijing("").NextSolarTerm().IsZero() || Parse("xxx").PrevSolarTerm().Error == nil {
+		t.Fatal("Expected zero value and error to be kept\n")
+	}
+
+	if Parse("2020-08-05").Timezone(Tokyo).NextSolarTerm().ToFormatString("Y-m-d H:i") != "2020-08-07 10:06" {
+		t.Fatalf("Expected the timezone to be kept, but got %s\n", Parse("2020-08-05").Timezone(Tokyo).NextSolarTerm().ToDateTimeString())
+	}
+}
+
+func TestSolarTermsInYear(t *testing.T) {
+	terms := SolarTermsInYear(2020)
+	if len(terms) != 24 {
+		t.Fatalf("Expected 24 solar terms, but got %d\n", len(terms))
+	}
+
+	Tests := []struct {
+		index  int    // 输入值
+		name   string // 期望节气名称
+		output string // 期望交节时刻
+	}{
+		{0, "小寒", "2020-01-06"},
+		{2, "立春", "2020-02-04 17:03:12"},
+		{11, "夏至", "2020-06-21"},
+		{23, "冬至", "2020-12-21"},
+	}
+
+	for _, v := range Tests {
+		term := terms[v.index]
+		output := term.Carbon.ToDateTimeString()
+		if len(v.output) == 10 {
+			output = term.Carbon.ToDateString()
+		}
+
+		if term.Name != v.name || output != v.output {
+			t.Fatalf("Input %d, expected %s %s, but got %s %s\n", v.index, v.name, v.output, term.Name, output)
+		}
+	}
+
+	if SolarTermsInYear(1899) != nil || SolarTermsInYear(2101) != nil {
+		t.Fatal("Expected nil with a year out of range\n")
+	}
+
+	if Timezone(Tokyo).SolarTermsInYear(2020)[2].Carbon.ToDateTimeString() != "2020-02-04 18:03:12" {
+		t.Fatal("Expected the timezone to be used\n")
+	}
+
+	for _, term := range Timezone(UTC).SolarTermsInYear(2020) {
+		if term.Carbon.SolarTerm() != term.Name {
+			t.Fatalf("Expected %s, but got %s at %s\n", term.Name, term.Carbon.SolarTerm(), term.Carbon.ToDateTimeString())
+		}
+	}
+}
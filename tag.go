@@ -0,0 +1,331 @@
+package carbon
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	carbonType      = reflect.TypeOf(Carbon{})
+	rawMessageType  = reflect.TypeOf(json.RawMessage(nil))
+	marshalerType   = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+)
+
+// jsonTag 通过carbon标签设置的JSON格式，布局模板与格式化符号二选一
+type jsonTag struct {
+	layout string // 布局模板，如2006-01-02
+	format string // 格式化符号，如Y-m-d
+}
+
+// tagField 影子结构体字段与原结构体字段的对应关系
+type tagField struct {
+	index  []int      // 原结构体中的字段路径，匿名结构体的字段按照encoding/json的规则展开
+	tag    *jsonTag   // carbon标签，非nil时原字段为Carbon或*Carbon，影子字段为json.RawMessage
+	nested *tagStruct // 含carbon标签的嵌套结构体，非nil时影子字段为对应的影子结构体
+}
+
+// tagStruct 含carbon标签的结构体对应的影子结构体，仅包含会被encoding/json处理的字段
+type tagStruct struct {
+	typ    reflect.Type
+	fields []tagField
+}
+
+// tagCandidate 构建影子结构体时的候选字段
+type tagCandidate struct {
+	tagField
+	name     string // JSON字段名
+	options  string // json标签选项，如,omitempty
+	named    bool   // 是否通过json标签指定了字段名
+	depth    int    // 匿名结构体的嵌套深度
+	fieldTyp reflect.Type
+}
+
+// MarshalJSON 按照结构体中Carbon及*Carbon字段的carbon标签输出JSON，其余字段与json.Marshal一致
+// 标签支持布局模板及格式化符号，如`carbon:"layout=2006-01-02"`、`carbon:"format=Y年m月d日"`
+func MarshalJSON(v interface{}) ([]byte, error) {
+	value := reflect.Indirect(reflect.ValueOf(v))
+	if value.Kind() != reflect.Struct || implementsJSON(value.Type()) {
+		return json.Marshal(v)
+	}
+	ts, err := buildTagStruct(value.Type(), map[reflect.Type]bool{})
+	if err != nil || ts == nil {
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(v)
+	}
+	shadow := reflect.New(ts.typ).Elem()
+	if err := ts.fill(shadow, value, true); err != nil {
+		return nil, err
+	}
+	return json.Marshal(shadow.Interface())
+}
+
+// UnmarshalJSON 按照结构体中Carbon及*Carbon字段的carbon标签解析JSON，其余字段与json.Unmarshal一致
+// 值为nil的*Carbon字段会自动创建，v必须是结构体指针
+func UnmarshalJSON(data []byte, v interface{}) error {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct || implementsJSON(value.Elem().Type()) {
+		return json.Unmarshal(data, v)
+	}
+	ts, err := buildTagStruct(value.Elem().Type(), map[reflect.Type]bool{})
+	if err != nil || ts == nil {
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(data, v)
+	}
+	shadow := reflect.New(ts.typ)
+	if err := ts.fill(shadow.Elem(), value.Elem(), false); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, shadow.Interface()); err != nil {
+		return err
+	}
+	return ts.restore(value.Elem(), shadow.Elem())
+}
+
+// buildTagStruct 构建结构体对应的影子结构体，结构体(含嵌套结构体)中没有carbon标签时返回nil
+func buildTagStruct(typ reflect.Type, visiting map[reflect.Type]bool) (*tagStruct, error) {
+	if visiting[typ] {
+		return nil, nil
+	}
+	visiting[typ] = true
+	defer delete(visiting, typ)
+
+	candidates, err := collectTagFields(typ, nil, 0, visiting)
+	if err != nil {
+		return nil, err
+	}
+	candidates = dominantTagFields(candidates)
+
+	ts, tagged := &tagStruct{}, false
+	fields := make([]reflect.StructField, 0, len(candidates))
+	for i, candidate := range candidates {
+		if candidate.tag != nil || candidate.nested != nil {
+			tagged = true
+		}
+		fields = append(fields, reflect.StructField{
+			Name: "F" + strconv.Itoa(i),
+			Type: candidate.fieldTyp,
+			Tag:  reflect.StructTag(`json:` + strconv.Quote(candidate.name+candidate.options)),
+		})
+		ts.fields = append(ts.fields, candidate.tagField)
+	}
+	if !tagged {
+		return nil, nil
+	}
+	ts.typ = reflect.StructOf(fields)
+	return ts, nil
+}
+
+// collectTagFields 收集会被encoding/json处理的字段，未导出(含未导出的匿名结构体)及json标签为"-"的字段将被忽略
+func collectTagFields(typ reflect.Type, index []int, depth int, visiting map[reflect.Type]bool) ([]tagCandidate, error) {
+	candidates := make([]tagCandidate, 0, typ.NumField())
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		jsonTag := field.Tag.Get("json")
+		if jsonTag == "-" {
+			continue
+		}
+		name, options := jsonTag, ""
+		if pos := strings.Index(jsonTag, ","); pos >= 0 {
+			name, options = jsonTag[:pos], jsonTag[pos:]
+		}
+		fieldIndex := append(append([]int{}, index...), i)
+		if field.PkgPath != "" {
+			continue
+		}
+		// 匿名结构体的字段提升至外层
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct && !implementsJSON(field.Type) {
+			nested, err := collectTagFields(field.Type, fieldIndex, depth+1, visiting)
+			if err != nil {
+				return nil, err
+			}
+			candidates = append(candidates, nested...)
+			continue
+		}
+		candidate := tagCandidate{
+			tagField: tagField{index: fieldIndex},
+			name:     name,
+			options:  options,
+			named:    name != "",
+			depth:    depth,
+			fieldTyp: field.Type,
+		}
+		if name == "" {
+			candidate.name = field.Name
+		}
+		if raw, ok := field.Tag.Lookup("carbon"); ok {
+			if field.Type != carbonType && field.Type != reflect.PtrTo(carbonType) {
+				return nil, invalidTagFieldError(field)
+			}
+			tag, err := parseTag(raw)
+			if err != nil {
+				return nil, err
+			}
+			candidate.tag, candidate.fieldTyp = &tag, rawMessageType
+		} else if elem := indirectType(field.Type); elem.Kind() == reflect.Struct && !implementsJSON(elem) {
+			nested, err := buildTagStruct(elem, visiting)
+			if err != nil {
+				return nil, err
+			}
+			if nested != nil {
+				candidate.nested, candidate.fieldTyp = nested, nested.typ
+				if field.Type.Kind() == reflect.Ptr {
+					candidate.fieldTyp = reflect.PtrTo(nested.typ)
+				}
+			}
+		}
+		candidates = append(candidates, candidate)
+	}
+	return candidates, nil
+}
+
+// dominantTagFields 按照encoding/json的规则处理同名字段，浅层字段优先，同层时仅保留唯一通过json标签命名的字段
+func dominantTagFields(candidates []tagCandidate) []tagCandidate {
+	dominant := make([]tagCandidate, 0, len(candidates))
+	for i, candidate := range candidates {
+		keep := true
+		for j, other := range candidates {
+			if i == j || other.name != candidate.name {
+				continue
+			}
+			if other.depth < candidate.depth || (other.depth == candidate.depth && (other.named || !candidate.named)) {
+				keep = false
+			}
+		}
+		if keep {
+			dominant = append(dominant, candidate)
+		}
+	}
+	return dominant
+}
+
+// fill 将原结构体的字段复制到影子结构体，输出JSON时按照carbon标签格式化，解析JSON时保留原值以便合并
+func (ts *tagStruct) fill(shadow reflect.Value, value reflect.Value, marshal bool) error {
+	for i, field := range ts.fields {
+		src, dst := value.FieldByIndex(field.index), shadow.Field(i)
+		switch {
+		case field.tag != nil:
+			if !marshal || (src.Kind() == reflect.Ptr && src.IsNil()) {
+				continue
+			}
+			data, err := reflect.Indirect(src).Interface().(Carbon).marshalByTag(*field.tag)
+			if err != nil {
+				return err
+			}
+			dst.SetBytes(data)
+		case field.nested != nil:
+			if src.Kind() == reflect.Ptr {
+				if src.IsNil() {
+					continue
+				}
+				dst.Set(reflect.New(field.nested.typ))
+				dst, src = dst.Elem(), src.Elem()
+			}
+			if err := field.nested.fill(dst, src, marshal); err != nil {
+				return err
+			}
+		default:
+			dst.Set(src)
+		}
+	}
+	return nil
+}
+
+// restore 将解析后的影子结构体复制回原结构体，带carbon标签的字段按照标签指定的格式解析，JSON中不存在的字段保持不变
+func (ts *tagStruct) restore(value reflect.Value, shadow reflect.Value) error {
+	for i, field := range ts.fields {
+		dst, src := value.FieldByIndex(field.index), shadow.Field(i)
+		switch {
+		case field.tag != nil:
+			data := src.Bytes()
+			if data == nil {
+				continue
+			}
+			if dst.Kind() == reflect.Ptr {
+				if string(data) == "null" {
+					dst.Set(reflect.Zero(dst.Type()))
+					continue
+				}
+				if dst.IsNil() {
+					dst.Set(reflect.ValueOf(&Carbon{loc: time.Local}))
+				}
+				dst = dst.Elem()
+			}
+			if err := dst.Addr().Interface().(*Carbon).unmarshalByTag(data, *field.tag); err != nil {
+				return err
+			}
+		case field.nested != nil:
+			if dst.Kind() == reflect.Ptr {
+				if src.IsNil() {
+					dst.Set(reflect.Zero(dst.Type()))
+					continue
+				}
+				if dst.IsNil() {
+					dst.Set(reflect.New(dst.Type().Elem()))
+				}
+				dst, src = dst.Elem(), src.Elem()
+			}
+			if err := field.nested.restore(dst, src); err != nil {
+				return err
+			}
+		default:
+			dst.Set(src)
+		}
+	}
+	return nil
+}
+
+// marshalByTag 按照carbon标签输出JSON字符串，零值输出空字符串
+func (c Carbon) marshalByTag(tag jsonTag) ([]byte, error) {
+	if tag.format != "" {
+		return c.MarshalJSONByFormat(tag.format)
+	}
+	if c.Time.IsZero() {
+		return []byte(`""`), nil
+	}
+	return []byte(strconv.Quote(c.Time.Format(tag.layout))), nil
+}
+
+// unmarshalByTag 按照carbon标签解析JSON字符串
+func (c *Carbon) unmarshalByTag(data []byte, tag jsonTag) error {
+	if tag.format != "" {
+		return c.UnmarshalJSONByFormat(data, tag.format)
+	}
+	return c.unmarshalByLayouts(data, tag.layout)
+}
+
+// parseTag 解析carbon标签，如layout=2006-01-02、format=Y-m-d
+func parseTag(raw string) (jsonTag, error) {
+	pos := strings.Index(raw, "=")
+	if pos < 0 || pos == len(raw)-1 {
+		return jsonTag{}, invalidTagError(raw)
+	}
+	switch key, value := strings.TrimSpace(raw[:pos]), raw[pos+1:]; key {
+	case "layout":
+		return jsonTag{layout: value}, nil
+	case "format":
+		return jsonTag{format: value}, nil
+	}
+	return jsonTag{}, invalidTagError(raw)
+}
+
+// implementsJSON 类型是否自定义了JSON的输出或解析，此类结构体整体交由encoding/json处理
+func implementsJSON(typ reflect.Type) bool {
+	ptr := reflect.PtrTo(typ)
+	return typ.Implements(marshalerType) || ptr.Implements(marshalerType) || ptr.Implements(unmarshalerType)
+}
+
+// indirectType 获取指针指向的类型
+func indirectType(typ reflect.Type) reflect.Type {
+	if typ.Kind() == reflect.Ptr {
+		return typ.Elem()
+	}
+	return typ
+}
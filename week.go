@@ -0,0 +1,57 @@
+package carbon
+
+import (
+	"sync"
+	"time"
+)
+
+var (
+	// 全局每周起始日
+	globalWeekStartsAt = time.Monday
+
+	// 每周起始日读写锁
+	weekMutex sync.RWMutex
+)
+
+// SetWeekStartsAt 设置全局每周起始日，默认为周一
+func SetWeekStartsAt(day time.Weekday) error {
+	if day < time.Sunday || day > time.Saturday {
+		return invalidWeekdayError(day)
+	}
+	weekMutex.Lock()
+	defer weekMutex.Unlock()
+	globalWeekStartsAt = day
+	return nil
+}
+
+// GetWeekStartsAt 获取全局每周起始日
+func GetWeekStartsAt() time.Weekday {
+	weekMutex.RLock()
+	defer weekMutex.RUnlock()
+	return globalWeekStartsAt
+}
+
+// SetWeekStartsAt 设置每周起始日(仅对当前实例有效)
+func (c Carbon) SetWeekStartsAt(day time.Weekday) Carbon {
+	if c.Error != nil {
+		return c
+	}
+	if day < time.Sunday || day > time.Saturday {
+		return c.withError(invalidWeekdayError(day))
+	}
+	c.weekStartsAt = &day
+	return c
+}
+
+// GetWeekStartsAt 获取当前实例每周起始日，未设置时返回全局每周起始日
+func (c Carbon) GetWeekStartsAt() time.Weekday {
+	if c.weekStartsAt == nil {
+		return GetWeekStartsAt()
+	}
+	return *c.weekStartsAt
+}
+
+// dayOfWeek 获取自每周起始日起经过的天数(0-6)
+func (c Carbon) dayOfWeek(t time.Time) int {
+	return (int(t.Weekday()) - int(c.GetWeekStartsAt()) + DaysPerWeek) % DaysPerWeek
+}
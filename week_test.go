@@ -0,0 +1,68 @@
+package carbon
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCarbon_SetWeekStartsAt(t *testing.T) {
+	Tests := []struct {
+		input       string       // 输入值
+		day         time.Weekday // 每周起始日
+		beginning   string       // 期望本周开始时间
+		end         string       // 期望本周结束时间
+		weekOfMonth int          // 期望本月的第几周
+		weekOfYear  int          // 期望本年的第几周
+	}{
+		{"2020-08-05 13:14:15", time.Monday, "2020-08-03 00:00:00", "2020-08-09 23:59:59", 2, 32},
+		{"2020-08-09 13:14:15", time.Monday, "2020-08-03 00:00:00", "2020-08-09 23:59:59", 2, 32},
+		{"2020-08-05 13:14:15", time.Sunday, "2020-08-02 00:00:00", "2020-08-08 23:59:59", 2, 32},
+		{"2020-08-09 13:14:15", time.Sunday, "2020-08-09 00:00:00", "2020-08-15 23:59:59", 3, 33},
+		{"2020-08-01 13:14:15", time.Saturday, "2020-08-01 00:00:00", "2020-08-07 23:59:59", 1, 32},
+		{"2020-08-07 13:14:15", time.Saturday, "2020-08-01 00:00:00", "2020-08-07 23:59:59", 1, 32},
+		{"2021-01-01 13:14:15", time.Sunday, "2020-12-27 00:00:00", "2021-01-02 23:59:59", 1, 1},
+		{"2021-01-03 13:14:15", time.Sunday, "2021-01-03 00:00:00", "2021-01-09 23:59:59", 2, 2},
+		{"2021-01-03 13:14:15", time.Monday, "2020-12-28 00:00:00", "2021-01-03 23:59:59", 1, 53},
+	}
+
+	for _, v := range Tests {
+		c := Parse(v.input).SetWeekStartsAt(v.day)
+
+		if output := c.BeginningOfWeek().ToDateTimeString(); output != v.beginning {
+			t.Fatalf("Input %s(%s), expected beginning %s, but got %s\n", v.input, v.day, v.beginning, output)
+		}
+
+		if output := c.EndOfWeek().ToDateTimeString(); output != v.end {
+			t.Fatalf("Input %s(%s), expected end %s, but got %s\n", v.input, v.day, v.end, output)
+		}
+
+		if output := c.WeekOfMonth(); output != v.weekOfMonth {
+			t.Fatalf("Input %s(%s), expected week of month %d, but got %d\n", v.input, v.day, v.weekOfMonth, output)
+		}
+
+		if output := c.WeekOfYear(); output != v.weekOfYear {
+			t.Fatalf("Input %s(%s), expected week of year %d, but got %d\n", v.input, v.day, v.weekOfYear, output)
+		}
+	}
+}
+
+func TestCarbon_SetWeekStartsAtGlobally(t *testing.T) {
+	if err := SetWeekStartsAt(time.Sunday); err != nil {
+		t.Fatalf("Unexpected error %v\n", err)
+	}
+	defer SetWeekStartsAt(time.Monday)
+
+	if GetWeekStartsAt() != time.Sunday || Parse("2020-08-05").BeginningOfWeek().ToDateString() != "2020-08-02" {
+		t.Fatal("Expected the global week start day to be used\n")
+	}
+
+	// 实例设置优先于全局设置，并在链式调用中传递
+	c := Parse("2020-08-05").SetWeekStartsAt(time.Monday).AddDays(1).Timezone(Tokyo)
+	if c.GetWeekStartsAt() != time.Monday || c.BeginningOfWeek().ToDateString() != "2020-08-03" {
+		t.Fatal("Expected the instance week start day to be passed along the chain\n")
+	}
+
+	if SetWeekStartsAt(7) == nil || Parse("2020-08-05").SetWeekStartsAt(-1).Error == nil {
+		t.Fatal("Expected error with an invalid weekday\n")
+	}
+}